@@ -0,0 +1,408 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	apikeyName        string
+	apikeyDescription string
+	apikeyRoles       []string
+	apikeyPermissions []string
+	apikeyYes         bool
+)
+
+// apikeyCmd represents the apikey command
+var apikeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "Manage API keys",
+	Long:  "List, create, delete, and rotate API keys on the Unraid server.",
+}
+
+// apikeyListCmd represents the apikey ls command
+var apikeyListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List API keys",
+	Long:    "List API keys with their roles and permissions. Secrets are never shown.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		keys, err := apiClient.GetAPIKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get API keys: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			if len(keys) == 0 {
+				fmt.Println("No API keys found")
+				return nil
+			}
+
+			headers := []string{"ID", "Name", "Description", "Roles", "Permissions", "Created"}
+			var rows [][]string
+
+			for _, key := range keys {
+				rows = append(rows, []string{
+					key.ID,
+					key.Name,
+					dashIfEmpty(key.Description),
+					dashIfEmpty(strings.Join(key.Roles, ", ")),
+					dashIfEmpty(formatPermissions(key.Permissions)),
+					formatAPIKeyCreated(key.CreatedAt),
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+		} else {
+			formatter.Print(keys)
+		}
+
+		return nil
+	},
+}
+
+// apikeyCreateCmd represents the apikey create command
+var apikeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API key",
+	Long: `Create an API key with roles and/or fine-grained permissions.
+
+Roles are ADMIN, CONNECT, GUEST, or VIEWER. Permissions are given as
+RESOURCE:ACTION[,ACTION...], e.g. DOCKER:READ_ANY,UPDATE_ANY. The secret is
+printed once and cannot be retrieved later.
+
+Examples:
+  unraidcli apikey create --name monitoring --role VIEWER
+  unraidcli apikey create --name backup --permission ARRAY:READ_ANY --permission SHARE:READ_ANY,UPDATE_ANY
+  unraidcli apikey create --name ci --role ADMIN --description "CI pipeline" -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if apikeyName == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		permissions, err := parsePermissions(apikeyPermissions)
+		if err != nil {
+			return err
+		}
+
+		if len(apikeyRoles) == 0 && len(permissions) == 0 {
+			return fmt.Errorf("at least one --role or --permission is required")
+		}
+
+		input := client.APIKeyInput{
+			Name:        apikeyName,
+			Description: apikeyDescription,
+			Permissions: permissions,
+		}
+		for _, role := range apikeyRoles {
+			input.Roles = append(input.Roles, strings.ToUpper(role))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		key, err := apiClient.CreateAPIKey(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to create API key: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ API key '%s' created (ID: %s)\n\n", key.Name, key.ID)
+			fmt.Printf("Key: %s\n\n", key.Key)
+			fmt.Println(output.Warning("Store this key now; it will not be shown again."))
+		} else {
+			formatter.Print(key)
+		}
+
+		return nil
+	},
+}
+
+// apikeyDeleteCmd represents the apikey delete command
+var apikeyDeleteCmd = &cobra.Command{
+	Use:     "delete <name-or-id>...",
+	Aliases: []string{"rm"},
+	Short:   "Delete API keys",
+	Long: `Delete one or more API keys by name or ID. Anything using a deleted key
+loses access immediately, so you must type 'delete' to confirm unless --yes
+is given.
+
+Examples:
+  unraidcli apikey delete monitoring
+  unraidcli apikey rm old-key other-key --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		findCtx, findCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		var keys []*client.APIKey
+		for _, arg := range args {
+			key, err := apiClient.FindAPIKey(findCtx, arg)
+			if err != nil {
+				findCancel()
+				return err
+			}
+			keys = append(keys, key)
+		}
+		findCancel()
+
+		if !apikeyYes {
+			fmt.Println("API keys to delete:")
+			for _, key := range keys {
+				fmt.Printf("    %s (%s)\n", key.Name, key.ID)
+			}
+
+			if err := confirmTyped(fmt.Sprintf("delete %d API key(s)", len(keys)), "delete"); err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		// The API deletes a list of keys in one call
+		ids := make([]string, 0, len(keys))
+		names := make([]string, 0, len(keys))
+		for _, key := range keys {
+			ids = append(ids, key.ID)
+			names = append(names, key.Name)
+		}
+
+		if err := apiClient.DeleteAPIKeys(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete API keys %v: %w", names, err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Deleted %d API key(s): %s\n", len(keys), strings.Join(names, ", "))
+		} else {
+			formatter.Print(map[string]interface{}{
+				"status":  "success",
+				"message": "API keys deleted successfully",
+				"ids":     ids,
+				"names":   names,
+			})
+		}
+
+		return nil
+	},
+}
+
+// apikeyRotateCmd represents the apikey rotate command
+var apikeyRotateCmd = &cobra.Command{
+	Use:   "rotate <name-or-id>",
+	Short: "Replace the configured API key with a new one",
+	Long: `Rotate the API key used by the current server profile.
+
+The API cannot tell which key the profile uses, so you must type the name
+of the given key to confirm that it is the configured one (or pass --yes).
+A new key is then created with the same description, roles, and permissions.
+The new key is verified with a test connection and saved to the config file,
+and only then is the old key deleted. If verification or saving fails, the
+new key is deleted again and the configuration is left unchanged.
+
+The new key is named after the old one with a timestamp suffix, unless
+--name is given.
+
+Examples:
+  unraidcli apikey rotate unraidcli
+  unraidcli apikey rotate unraidcli --server remote --name unraidcli-remote`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, err := currentServerName()
+		if err != nil {
+			return err
+		}
+
+		server, err := cfg.GetServer(profile)
+		if err != nil {
+			return err
+		}
+
+		findCtx, findCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		old, err := apiClient.FindAPIKey(findCtx, args[0])
+		findCancel()
+		if err != nil {
+			return err
+		}
+
+		// The old key is deleted at the end, so make sure it is the one this
+		// profile uses before creating anything
+		if !apikeyYes {
+			fmt.Printf("API key '%s' (%s) will be replaced and deleted.\n", old.Name, old.ID)
+			fmt.Printf("It must be the key configured for profile '%s'; anything else using it will lose access.\n", profile)
+			if err := confirmTyped(fmt.Sprintf("confirm '%s' is the key used by '%s'", old.Name, profile), old.Name); err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		// Progress goes to stderr with structured output, so stdout only
+		// holds the result
+		table := outputFormat == "" || outputFormat == "table"
+		progress := io.Writer(os.Stdout)
+		if !table {
+			progress = os.Stderr
+		}
+
+		name := apikeyName
+		if name == "" {
+			name = rotatedKeyName(old.Name, time.Now())
+		}
+
+		fmt.Fprintf(progress, "Creating API key '%s'... ", name)
+		key, err := apiClient.CreateAPIKey(ctx, client.APIKeyInput{
+			Name:        name,
+			Description: old.Description,
+			Roles:       old.Roles,
+			Permissions: old.Permissions,
+		})
+		if err != nil {
+			fmt.Fprintf(progress, "✗\n")
+			return fmt.Errorf("failed to create API key: %w", err)
+		}
+		fmt.Fprintf(progress, "✓\n")
+
+		// rollback deletes the new key, leaving the old one in place
+		rollback := func(cause error) error {
+			fmt.Fprintf(progress, "Rolling back, deleting API key '%s'... ", key.Name)
+			if err := apiClient.DeleteAPIKeys(ctx, []string{key.ID}); err != nil {
+				fmt.Fprintf(progress, "✗\n")
+				return fmt.Errorf("%w (rollback failed, delete key %s manually: %v)", cause, key.ID, err)
+			}
+			fmt.Fprintf(progress, "✓\n")
+			return cause
+		}
+
+		fmt.Fprint(progress, "Verifying new key... ")
+		newClient := client.New(server.URL, key.Key)
+		if err := newClient.TestConnection(ctx); err != nil {
+			fmt.Fprintf(progress, "✗\n")
+			return rollback(fmt.Errorf("connection test with new key failed: %w", err))
+		}
+		fmt.Fprintf(progress, "✓\n")
+
+		fmt.Fprintf(progress, "Saving new key to profile '%s'... ", profile)
+		cfg.SetServer(profile, server.URL, key.Key)
+		if err := cfg.Save(); err != nil {
+			fmt.Fprintf(progress, "✗\n")
+			cfg.SetServer(profile, server.URL, server.APIKey)
+			return rollback(fmt.Errorf("failed to save config: %w", err))
+		}
+		fmt.Fprintf(progress, "✓\n")
+
+		fmt.Fprintf(progress, "Deleting old API key '%s'... ", old.Name)
+		if err := newClient.DeleteAPIKeys(ctx, []string{old.ID}); err != nil {
+			fmt.Fprintf(progress, "✗\n")
+			return fmt.Errorf("new key is saved, but failed to delete old key %s: %w", old.ID, err)
+		}
+		fmt.Fprintf(progress, "✓\n")
+
+		// If the previously configured key still works, it was not the key
+		// that was just deleted
+		warning := ""
+		if err := apiClient.TestConnection(ctx); err == nil {
+			warning = fmt.Sprintf("The previously configured key still works, so it was not '%s'. Delete it with 'unraidcli apikey delete'.", old.Name)
+		}
+
+		// Never include the new key itself in the output
+		if table {
+			if warning != "" {
+				fmt.Println(output.Warning(warning))
+			}
+			fmt.Printf("✓ API key for '%s' rotated\n", profile)
+		} else {
+			result := map[string]string{
+				"status":     "success",
+				"message":    "API key rotated successfully",
+				"profile":    profile,
+				"oldKeyId":   old.ID,
+				"oldKeyName": old.Name,
+				"newKeyId":   key.ID,
+				"newKeyName": key.Name,
+			}
+			if warning != "" {
+				result["warning"] = warning
+			}
+			formatter.Print(result)
+		}
+		return nil
+	},
+}
+
+// rotatedKeySuffix matches the timestamp suffix added by rotatedKeyName
+var rotatedKeySuffix = regexp.MustCompile(`-\d{8}-\d{6}$`)
+
+// rotatedKeyName returns the name for a replacement of the named key,
+// replacing any timestamp suffix from an earlier rotation
+func rotatedKeyName(name string, now time.Time) string {
+	return rotatedKeySuffix.ReplaceAllString(name, "") + "-" + now.Format("20060102-150405")
+}
+
+// parsePermissions parses RESOURCE:ACTION[,ACTION...] permission flags
+func parsePermissions(values []string) ([]client.APIKeyPermission, error) {
+	var permissions []client.APIKeyPermission
+
+	for _, value := range values {
+		resource, actions, ok := strings.Cut(value, ":")
+		if !ok || resource == "" || actions == "" {
+			return nil, fmt.Errorf("invalid permission '%s' (use RESOURCE:ACTION[,ACTION...])", value)
+		}
+
+		permission := client.APIKeyPermission{Resource: strings.ToUpper(strings.TrimSpace(resource))}
+		for _, action := range strings.Split(actions, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				permission.Actions = append(permission.Actions, strings.ToUpper(action))
+			}
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+// formatPermissions formats permissions as RESOURCE:ACTION,... pairs
+func formatPermissions(permissions []client.APIKeyPermission) string {
+	var parts []string
+	for _, permission := range permissions {
+		parts = append(parts, permission.Resource+":"+strings.Join(permission.Actions, ","))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// formatAPIKeyCreated formats a key's creation time as a date
+func formatAPIKeyCreated(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return dashIfEmpty(createdAt)
+	}
+	return t.Local().Format("2006-01-02")
+}
+
+func init() {
+	rootCmd.AddCommand(apikeyCmd)
+	apikeyCmd.AddCommand(apikeyListCmd)
+	apikeyCmd.AddCommand(apikeyCreateCmd)
+	apikeyCmd.AddCommand(apikeyDeleteCmd)
+	apikeyCmd.AddCommand(apikeyRotateCmd)
+
+	apikeyCreateCmd.Flags().StringVar(&apikeyName, "name", "", "Key name")
+	apikeyCreateCmd.Flags().StringVar(&apikeyDescription, "description", "", "Key description")
+	apikeyCreateCmd.Flags().StringSliceVar(&apikeyRoles, "role", nil, "Role(s): ADMIN, CONNECT, GUEST, VIEWER (comma-separated or repeated)")
+	apikeyCreateCmd.Flags().StringArrayVar(&apikeyPermissions, "permission", nil, "Permission as RESOURCE:ACTION[,ACTION...] (repeatable)")
+	apikeyDeleteCmd.Flags().BoolVarP(&apikeyYes, "yes", "y", false, "Skip the confirmation prompt")
+	apikeyRotateCmd.Flags().StringVar(&apikeyName, "name", "", "Name of the new key (default: old name with a timestamp)")
+	apikeyRotateCmd.Flags().BoolVarP(&apikeyYes, "yes", "y", false, "Skip confirming that the key is the one this profile uses")
+}
@@ -3,13 +3,25 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/01dnot/unraidcli/internal/client"
 	"github.com/01dnot/unraidcli/internal/output"
 	"github.com/spf13/cobra"
 )
 
+var (
+	spinAll       bool
+	arrayYes      bool
+	arrayGraceful bool
+	arrayWait     bool
+
+	usageSort      string
+	usageThreshold float64
+)
+
 // arrayCmd represents the array command
 var arrayCmd = &cobra.Command{
 	Use:   "array",
@@ -32,14 +44,9 @@ var arrayStatusCmd = &cobra.Command{
 		}
 
 		if outputFormat == "" || outputFormat == "table" {
-			// Parse and convert kilobytes to bytes for formatting
-			totalKB, _ := strconv.ParseInt(arrayInfo.Capacity.Kilobytes.Total, 10, 64)
-			usedKB, _ := strconv.ParseInt(arrayInfo.Capacity.Kilobytes.Used, 10, 64)
-			freeKB, _ := strconv.ParseInt(arrayInfo.Capacity.Kilobytes.Free, 10, 64)
-
-			totalBytes := totalKB * 1024
-			usedBytes := usedKB * 1024
-			freeBytes := freeKB * 1024
+			totalBytes := arrayInfo.Capacity.Kilobytes.Total.Bytes()
+			usedBytes := arrayInfo.Capacity.Kilobytes.Used.Bytes()
+			freeBytes := arrayInfo.Capacity.Kilobytes.Free.Bytes()
 
 			usedPercent := float64(0)
 			if totalBytes > 0 {
@@ -57,7 +64,7 @@ var arrayStatusCmd = &cobra.Command{
 
 			// Print disk table
 			if len(allDisks) > 0 {
-				headers := []string{"Name", "Device", "Type", "Status", "Size", "Temp", "FS Type"}
+				headers := []string{"Name", "Device", "Type", "Status", "Spin", "Size", "Temp", "FS Type"}
 				var rows [][]string
 
 				for _, disk := range allDisks {
@@ -67,11 +74,17 @@ var arrayStatusCmd = &cobra.Command{
 						tempStr = output.ColorizeTemperature(temp)
 					}
 
+					spin := "-"
+					if isSpinnable(disk) {
+						spin = formatSpinState(disk.IsSpinning)
+					}
+
 					rows = append(rows, []string{
 						disk.Name,
 						disk.Device,
 						disk.Type,
 						output.ColorizeState(disk.Status),
+						spin,
 						output.FormatBytes(disk.Size),
 						tempStr,
 						disk.FsType,
@@ -88,13 +101,130 @@ var arrayStatusCmd = &cobra.Command{
 	},
 }
 
+// diskUsage is the filesystem usage of a single data or cache disk
+type diskUsage struct {
+	Name        string  `json:"name" yaml:"name"`
+	Type        string  `json:"type" yaml:"type"`
+	SizeBytes   int64   `json:"sizeBytes" yaml:"sizeBytes"`
+	UsedBytes   int64   `json:"usedBytes" yaml:"usedBytes"`
+	FreeBytes   int64   `json:"freeBytes" yaml:"freeBytes"`
+	UsedPercent float64 `json:"usedPercent" yaml:"usedPercent"`
+	Flagged     bool    `json:"flagged" yaml:"flagged"`
+}
+
+// arrayUsageCmd represents the array usage command
+var arrayUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show per-disk capacity and utilization",
+	Long: `Display used and free space for each data and cache disk, with usage bars.
+Disks filled to --threshold percent or more are flagged.
+
+Examples:
+  unraidcli array usage
+  unraidcli array usage --sort fill
+  unraidcli array usage --threshold 85 -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		arrayInfo, err := apiClient.GetArrayInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get array info: %w", err)
+		}
+
+		// Only data and cache disks hold files; parity and boot are excluded
+		disks := append([]client.ArrayDisk{}, arrayInfo.Disks...)
+		disks = append(disks, arrayInfo.Caches...)
+
+		var usages []diskUsage
+		for _, disk := range disks {
+			if disk.FsSize <= 0 {
+				continue
+			}
+			usages = append(usages, diskUsage{
+				Name:        disk.Name,
+				Type:        disk.Type,
+				SizeBytes:   disk.FsSize.Bytes(),
+				UsedBytes:   disk.FsUsed.Bytes(),
+				FreeBytes:   disk.FsFree.Bytes(),
+				UsedPercent: disk.UsedPercent(),
+				Flagged:     disk.UsedPercent() >= usageThreshold,
+			})
+		}
+
+		if len(usages) == 0 {
+			fmt.Println("No mounted data or cache disks found.")
+			return nil
+		}
+
+		switch usageSort {
+		case "", "name":
+			// Keep array order
+		case "fill":
+			sort.SliceStable(usages, func(i, j int) bool {
+				return usages[i].UsedPercent > usages[j].UsedPercent
+			})
+		default:
+			return fmt.Errorf("invalid --sort value '%s' (use name or fill)", usageSort)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			headers := []string{"Name", "Type", "Size", "Used", "Free", "% Used", "Usage", ""}
+			var rows [][]string
+
+			fullest := usages[0]
+			for _, usage := range usages {
+				if usage.UsedPercent > fullest.UsedPercent {
+					fullest = usage
+				}
+
+				flag := ""
+				if usage.Flagged {
+					flag = output.BoldYellow("⚠")
+				}
+
+				rows = append(rows, []string{
+					usage.Name,
+					usage.Type,
+					output.FormatBytes(usage.SizeBytes),
+					output.FormatBytes(usage.UsedBytes),
+					output.FormatBytes(usage.FreeBytes),
+					output.ColorizePercentage(usage.UsedPercent, false),
+					output.UsageBar(usage.UsedPercent, 20, false),
+					flag,
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+
+			fmt.Printf("\nFullest disk: %s (%s)\n", fullest.Name, output.ColorizePercentage(fullest.UsedPercent, false))
+
+			flagged := 0
+			for _, usage := range usages {
+				if usage.Flagged {
+					flagged++
+				}
+			}
+			if flagged > 0 {
+				fmt.Printf("%s\n", output.Warning(fmt.Sprintf("%d disk(s) at or above %.0f%% full", flagged, usageThreshold)))
+			}
+		} else {
+			formatter.Print(usages)
+		}
+
+		return nil
+	},
+}
+
 // arrayStartCmd represents the array start command
 var arrayStartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start the array",
-	Long:  "Start the Unraid storage array.",
+	Long: `Start the Unraid storage array.
+
+Use --wait to block until the array reports STARTED.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), arrayTimeout())
 		defer cancel()
 
 		fmt.Println("Starting array...")
@@ -102,6 +232,12 @@ var arrayStartCmd = &cobra.Command{
 			return fmt.Errorf("failed to start array: %w", err)
 		}
 
+		if arrayWait {
+			if err := waitForArrayState(ctx, "STARTED"); err != nil {
+				return err
+			}
+		}
+
 		if outputFormat == "" || outputFormat == "table" {
 			fmt.Println("✓ Array started successfully")
 		} else {
@@ -119,16 +255,59 @@ var arrayStartCmd = &cobra.Command{
 var arrayStopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Stop the array",
-	Long:  "Stop the Unraid storage array.",
+	Long: `Stop the Unraid storage array.
+
+Stopping the array stops every running container and VM and cancels any
+active parity check. These are listed first, and you must type 'stop' to
+confirm unless --yes is given.
+
+Use --graceful to stop containers and VMs in reverse autostart order before
+stopping the array, and --wait to block until the array reports STOPPED.
+
+Examples:
+  unraidcli array stop
+  unraidcli array stop --graceful --wait
+  unraidcli array stop --yes`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		preflightCtx, preflightCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		summary, err := collectPreflight(preflightCtx)
+		preflightCancel()
+		if err != nil {
+			return fmt.Errorf("preflight check failed: %w", err)
+		}
+
+		fmt.Println("Preflight check:")
+		summary.Print()
+
+		if !arrayYes {
+			if err := confirmTyped("stop the array", "stop"); err != nil {
+				return err
+			}
+		}
+
+		// Start the timeout only after confirmation, so time spent at the
+		// prompt does not count against it
+		ctx, cancel := context.WithTimeout(context.Background(), arrayTimeout())
 		defer cancel()
 
-		fmt.Println("Stopping array...")
+		if arrayGraceful && !summary.Empty() {
+			fmt.Println("\nStopping workloads...")
+			if err := summary.StopWorkloads(ctx); err != nil {
+				return fmt.Errorf("graceful stop failed, array not stopped: %w", err)
+			}
+		}
+
+		fmt.Println("\nStopping array...")
 		if err := apiClient.StopArray(ctx); err != nil {
 			return fmt.Errorf("failed to stop array: %w", err)
 		}
 
+		if arrayWait {
+			if err := waitForArrayState(ctx, "STOPPED"); err != nil {
+				return err
+			}
+		}
+
 		if outputFormat == "" || outputFormat == "table" {
 			fmt.Println("✓ Array stopped successfully")
 		} else {
@@ -142,9 +321,438 @@ var arrayStopCmd = &cobra.Command{
 	},
 }
 
+// arrayTimeout returns the timeout for array start/stop, which is longer when
+// waiting for the state change or stopping workloads first
+func arrayTimeout() time.Duration {
+	if arrayWait || arrayGraceful {
+		return 15 * time.Minute
+	}
+	return 30 * time.Second
+}
+
+// waitForArrayState polls the array until it reports the desired state
+func waitForArrayState(ctx context.Context, state string) error {
+	fmt.Printf("Waiting for array to reach %s...\n", state)
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		arrayInfo, err := apiClient.GetArrayInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get array info: %w", err)
+		}
+
+		if strings.EqualFold(arrayInfo.State, state) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for array to reach %s (currently %s)", state, arrayInfo.State)
+		case <-ticker.C:
+		}
+	}
+}
+
+// arrayDiskCmd represents the array disk command
+var arrayDiskCmd = &cobra.Command{
+	Use:   "disk <name|device|serial>",
+	Short: "Show detailed disk information",
+	Long: `Display detailed information about a single disk, including model, serial
+number, spin state, filesystem usage, I/O counters, and SMART attributes.
+SMART attributes that indicate a failing disk are highlighted.
+
+Examples:
+  unraidcli array disk disk1
+  unraidcli array disk sdb
+  unraidcli array disk WD-WCC4N1234567`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		ref := args[0]
+
+		arrayInfo, err := apiClient.GetArrayInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get array info: %w", err)
+		}
+
+		disks, err := apiClient.GetDisks(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get disks: %w", err)
+		}
+
+		arrayDisk := arrayInfo.FindDisk(ref)
+
+		// Match the physical disk by serial number, or by the array disk's device
+		var physical *client.Disk
+		for i, disk := range disks {
+			device := strings.TrimPrefix(disk.Device, "/dev/")
+			if disk.SerialNum == ref || disk.ID == ref ||
+				(arrayDisk != nil && arrayDisk.Device != "" && device == arrayDisk.Device) ||
+				(arrayDisk == nil && device == strings.TrimPrefix(ref, "/dev/")) {
+				physical = &disks[i]
+				break
+			}
+		}
+
+		if arrayDisk == nil && physical != nil {
+			arrayDisk = arrayInfo.FindDisk(physical.Device)
+		}
+
+		if arrayDisk == nil && physical == nil {
+			return fmt.Errorf("disk '%s' not found", ref)
+		}
+
+		// Fetch SMART attributes for the physical disk
+		if physical != nil {
+			detail, err := apiClient.GetDisk(ctx, physical.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get SMART data: %w", err)
+			}
+			physical = detail
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			printDiskDetail(arrayDisk, physical)
+		} else {
+			var failing []client.SmartAttribute
+			if physical != nil {
+				for _, attr := range physical.SmartAttributes {
+					if smartSeverity(attr) != "" {
+						failing = append(failing, attr)
+					}
+				}
+			}
+
+			formatter.Print(struct {
+				ArrayDisk         *client.ArrayDisk       `json:"arrayDisk,omitempty" yaml:"arrayDisk,omitempty"`
+				Disk              *client.Disk            `json:"disk,omitempty" yaml:"disk,omitempty"`
+				FailingAttributes []client.SmartAttribute `json:"failingAttributes" yaml:"failingAttributes"`
+			}{arrayDisk, physical, failing})
+		}
+
+		return nil
+	},
+}
+
+// arraySpinUpCmd represents the array spin-up command
+var arraySpinUpCmd = &cobra.Command{
+	Use:   "spin-up <disk...>",
+	Short: "Spin up array disks",
+	Long: `Spin up one or more array disks by name or device, or all disks with --all.
+
+Examples:
+  unraidcli array spin-up disk1 disk2
+  unraidcli array spin-up --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSpin(args, true)
+	},
+}
+
+// arraySpinDownCmd represents the array spin-down command
+var arraySpinDownCmd = &cobra.Command{
+	Use:   "spin-down <disk...>",
+	Short: "Spin down array disks",
+	Long: `Spin down one or more array disks by name or device, or all disks with --all.
+
+Examples:
+  unraidcli array spin-down disk1 disk2
+  unraidcli array spin-down --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSpin(args, false)
+	},
+}
+
+// runSpin spins the given disks (or all spinnable disks) up or down
+func runSpin(args []string, up bool) error {
+	if spinAll && len(args) > 0 {
+		return fmt.Errorf("specify disks or --all, not both")
+	}
+	if !spinAll && len(args) == 0 {
+		return fmt.Errorf("specify at least one disk or use --all")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	arrayInfo, err := apiClient.GetArrayInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get array info: %w", err)
+	}
+
+	var disks []client.ArrayDisk
+	if spinAll {
+		for _, disk := range arrayInfo.AllDisks() {
+			if isSpinnable(disk) {
+				disks = append(disks, disk)
+			}
+		}
+	} else {
+		for _, ref := range args {
+			disk := arrayInfo.FindDisk(ref)
+			if disk == nil {
+				return fmt.Errorf("disk '%s' not found", ref)
+			}
+			if !isSpinnable(*disk) {
+				return fmt.Errorf("disk '%s' cannot be spun up or down", ref)
+			}
+			disks = append(disks, *disk)
+		}
+	}
+
+	direction := "down"
+	if up {
+		direction = "up"
+	}
+
+	table := outputFormat == "" || outputFormat == "table"
+	if table {
+		fmt.Printf("Spinning %s %d disk(s)...\n", direction, len(disks))
+	}
+
+	var failures []string
+	spun := []string{}
+	unchanged := []string{}
+	for _, disk := range disks {
+		if table {
+			fmt.Printf("  Spinning %s '%s'... ", direction, disk.Name)
+		}
+
+		if disk.IsSpinning == up {
+			if table {
+				fmt.Printf("already %s\n", formatSpinState(up))
+			}
+			unchanged = append(unchanged, disk.Name)
+			continue
+		}
+
+		var err error
+		if up {
+			err = apiClient.SpinUpDisk(ctx, disk.ID)
+		} else {
+			err = apiClient.SpinDownDisk(ctx, disk.ID)
+		}
+
+		if err != nil {
+			if table {
+				fmt.Printf("✗ Failed: %v\n", err)
+			}
+			failures = append(failures, disk.Name)
+		} else {
+			if table {
+				fmt.Printf("✓\n")
+			}
+			spun = append(spun, disk.Name)
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to spin %s %d disk(s): %v", direction, len(failures), failures)
+	}
+
+	if table {
+		fmt.Printf("\n✓ Successfully spun %s %d disk(s)\n", direction, len(disks))
+	} else {
+		formatter.Print(map[string]interface{}{
+			"status":    "success",
+			"message":   fmt.Sprintf("Successfully spun %s %d disk(s)", direction, len(disks)),
+			"disks":     spun,
+			"unchanged": unchanged,
+		})
+	}
+	return nil
+}
+
+// isSpinnable reports whether a disk has a spin state (the boot flash device does not)
+func isSpinnable(disk client.ArrayDisk) bool {
+	return !strings.EqualFold(disk.Type, "flash") && disk.Device != ""
+}
+
+// printDiskDetail prints the details of an array disk and its physical disk
+func printDiskDetail(arrayDisk *client.ArrayDisk, physical *client.Disk) {
+	if arrayDisk != nil {
+		fmt.Printf("Name: %s\n", arrayDisk.Name)
+		fmt.Printf("Device: %s\n", arrayDisk.Device)
+		fmt.Printf("Type: %s\n", arrayDisk.Type)
+		fmt.Printf("Status: %s\n", output.ColorizeState(arrayDisk.Status))
+	} else {
+		fmt.Printf("Device: %s\n", physical.Device)
+		fmt.Printf("Status: %s\n", output.Gray("not assigned to the array"))
+	}
+
+	if physical != nil {
+		model := strings.TrimSpace(physical.Vendor + " " + physical.Name)
+		fmt.Printf("Model: %s\n", model)
+		fmt.Printf("Serial: %s\n", physical.SerialNum)
+		if physical.InterfaceType != "" {
+			fmt.Printf("Interface: %s\n", physical.InterfaceType)
+		}
+		fmt.Printf("Size: %s\n", output.FormatBytes(physical.Size))
+	} else {
+		fmt.Printf("Size: %s\n", output.FormatBytes(arrayDisk.Size))
+	}
+
+	if arrayDisk != nil {
+		fmt.Printf("Rotational: %s\n", yesNo(arrayDisk.Rotational))
+		fmt.Printf("Spin State: %s\n", formatSpinState(arrayDisk.IsSpinning))
+		if arrayDisk.Temperature > 0 {
+			fmt.Printf("Temperature: %s\n", output.ColorizeTemperature(float64(arrayDisk.Temperature)))
+		}
+
+		if arrayDisk.FsSize > 0 {
+			fmt.Printf("Filesystem: %s\n", arrayDisk.FsType)
+			fmt.Printf("Used: %s / %s (%s)\n",
+				output.FormatBytes(arrayDisk.FsUsed.Bytes()),
+				output.FormatBytes(arrayDisk.FsSize.Bytes()),
+				output.ColorizePercentage(arrayDisk.UsedPercent(), false))
+			fmt.Printf("Free: %s\n", output.FormatBytes(arrayDisk.FsFree.Bytes()))
+		}
+
+		errors := fmt.Sprintf("%d", arrayDisk.NumErrors)
+		if arrayDisk.NumErrors > 0 {
+			errors = output.Red(errors)
+		}
+		fmt.Printf("Reads: %d\n", arrayDisk.NumReads)
+		fmt.Printf("Writes: %d\n", arrayDisk.NumWrites)
+		fmt.Printf("Errors: %s\n", errors)
+	}
+
+	if physical == nil {
+		return
+	}
+
+	fmt.Printf("\nSMART Status: %s\n", output.ColorizeState(physical.SmartStatus))
+
+	// Summary of the attributes that matter most for disk health
+	summary := []struct {
+		label string
+		id    int
+	}{
+		{"Reallocated Sectors", client.SmartReallocatedSectors},
+		{"Pending Sectors", client.SmartPendingSectors},
+		{"Power-On Hours", client.SmartPowerOnHours},
+		{"CRC Errors", client.SmartCRCErrors},
+	}
+	for _, item := range summary {
+		attr := physical.SmartAttribute(item.id)
+		if attr == nil {
+			continue
+		}
+		fmt.Printf("%s: %s\n", item.label, colorizeSmartValue(*attr, fmt.Sprintf("%d", attr.Raw())))
+	}
+
+	if len(physical.SmartAttributes) == 0 {
+		return
+	}
+
+	fmt.Println("\nSMART Attributes:")
+	headers := []string{"ID", "Attribute", "Value", "Worst", "Threshold", "Raw", "Status"}
+	var rows [][]string
+	failing := 0
+
+	for _, attr := range physical.SmartAttributes {
+		status := output.Green("OK")
+		switch smartSeverity(attr) {
+		case "failed":
+			status = output.BoldRed("FAILING")
+			failing++
+		case "warning":
+			status = output.Yellow("WARNING")
+			failing++
+		}
+
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", attr.ID),
+			attr.Name,
+			fmt.Sprintf("%d", attr.Value),
+			fmt.Sprintf("%d", attr.Worst),
+			fmt.Sprintf("%d", attr.Threshold),
+			colorizeSmartValue(attr, attr.RawValue),
+			status,
+		})
+	}
+
+	formatter.PrintTable(headers, rows)
+
+	if failing > 0 {
+		fmt.Printf("\n%s\n", output.Warning(fmt.Sprintf("%d SMART attribute(s) indicate a problem", failing)))
+	}
+}
+
+// smartSeverity classifies a SMART attribute as "failed", "warning", or ""
+// when the attribute looks healthy
+func smartSeverity(attr client.SmartAttribute) string {
+	if attr.WhenFailed != "" && attr.WhenFailed != "-" {
+		return "failed"
+	}
+	if attr.Threshold > 0 && attr.Value > 0 && attr.Value <= attr.Threshold {
+		return "failed"
+	}
+
+	// Any non-zero count of these indicates media or cabling problems
+	switch attr.ID {
+	case client.SmartReallocatedSectors, client.SmartReportedUncorrect,
+		client.SmartPendingSectors, client.SmartOfflineUncorrect, client.SmartCRCErrors:
+		if attr.Raw() > 0 {
+			return "warning"
+		}
+	}
+
+	return ""
+}
+
+// colorizeSmartValue colors text according to the attribute's severity
+func colorizeSmartValue(attr client.SmartAttribute, text string) string {
+	switch smartSeverity(attr) {
+	case "failed":
+		return output.BoldRed(text)
+	case "warning":
+		return output.Yellow(text)
+	default:
+		return text
+	}
+}
+
+// formatSpinState formats a disk's spin state
+func formatSpinState(spinning bool) string {
+	if spinning {
+		return output.Green("active")
+	}
+	return output.Gray("standby")
+}
+
+// yesNo formats a boolean as plain Yes/No
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
+
 func init() {
 	rootCmd.AddCommand(arrayCmd)
 	arrayCmd.AddCommand(arrayStatusCmd)
 	arrayCmd.AddCommand(arrayStartCmd)
 	arrayCmd.AddCommand(arrayStopCmd)
+	arrayCmd.AddCommand(arrayDiskCmd)
+	arrayCmd.AddCommand(arrayUsageCmd)
+	arrayCmd.AddCommand(arraySpinUpCmd)
+	arrayCmd.AddCommand(arraySpinDownCmd)
+
+	// Add flags for array usage
+	arrayUsageCmd.Flags().StringVar(&usageSort, "sort", "name", "Sort order: name (array order) or fill (fullest first)")
+	arrayUsageCmd.Flags().Float64Var(&usageThreshold, "threshold", 90, "Flag disks at or above this percentage full")
+
+	// Add flags for array start and stop
+	arrayStartCmd.Flags().BoolVar(&arrayWait, "wait", false, "Wait until the array is STARTED")
+	arrayStopCmd.Flags().BoolVarP(&arrayYes, "yes", "y", false, "Skip the confirmation prompt")
+	arrayStopCmd.Flags().BoolVar(&arrayGraceful, "graceful", false, "Stop containers and VMs before stopping the array")
+	arrayStopCmd.Flags().BoolVar(&arrayWait, "wait", false, "Wait until the array is STOPPED")
+
+	// Add flags for array spin-up and spin-down
+	arraySpinUpCmd.Flags().BoolVar(&spinAll, "all", false, "Spin up all array disks")
+	arraySpinDownCmd.Flags().BoolVar(&spinAll, "all", false, "Spin down all array disks")
 }
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/history"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	forecastDays int
+	forecastKind string
+)
+
+// forecastCmd represents the forecast command
+var forecastCmd = &cobra.Command{
+	Use:   "forecast",
+	Short: "Predict when the array, disks, and shares will fill",
+	Long: `Fit a growth trend to recorded usage samples and estimate the number of days
+until the array, each disk, and each share is full.
+
+Samples are recorded by 'unraidcli forecast collect', which is meant to be run
+periodically (e.g. from cron). At least two samples are needed for a forecast.
+
+Examples:
+  unraidcli forecast
+  unraidcli forecast --kind share --days 60
+  unraidcli forecast -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		server, err := currentServerName()
+		if err != nil {
+			return err
+		}
+
+		store, err := history.Open(server)
+		if err != nil {
+			return err
+		}
+
+		since := time.Now().AddDate(0, 0, -forecastDays)
+		samples, err := store.Load(since)
+		if err != nil {
+			return err
+		}
+
+		forecasts := history.BuildForecasts(samples)
+
+		if forecastKind != "" {
+			var filtered []history.Forecast
+			for _, forecast := range forecasts {
+				if forecast.Kind == forecastKind {
+					filtered = append(filtered, forecast)
+				}
+			}
+			forecasts = filtered
+		}
+
+		if len(forecasts) == 0 {
+			fmt.Printf("Not enough usage history for a forecast (%d sample(s) in the last %d days).\n", len(samples), forecastDays)
+			fmt.Println("Run 'unraidcli forecast collect' periodically to record samples.")
+			return nil
+		}
+
+		// Soonest to fill first, then entities that are not growing
+		sort.SliceStable(forecasts, func(i, j int) bool {
+			a, b := forecasts[i].DaysUntilFull, forecasts[j].DaysUntilFull
+			if a == nil || b == nil {
+				return a != nil && b == nil
+			}
+			return *a < *b
+		})
+
+		if outputFormat == "" || outputFormat == "table" {
+			headers := []string{"Kind", "Name", "Used", "Size", "% Used", "Growth/Day", "Days Until Full", "Full By"}
+			var rows [][]string
+
+			for _, forecast := range forecasts {
+				growth := "-"
+				if forecast.GrowthPerDay > 0 {
+					growth = "+" + output.FormatBytes(int64(forecast.GrowthPerDay))
+				} else if forecast.GrowthPerDay < 0 {
+					growth = "-" + output.FormatBytes(int64(-forecast.GrowthPerDay))
+				}
+
+				days := output.Green("not growing")
+				fullBy := ""
+				if forecast.DaysUntilFull != nil {
+					days = colorizeDaysUntilFull(*forecast.DaysUntilFull)
+					if forecast.FullDate != nil {
+						fullBy = forecast.FullDate.Format("2006-01-02")
+					}
+				}
+
+				rows = append(rows, []string{
+					forecast.Kind,
+					forecast.Name,
+					output.FormatBytes(forecast.Used),
+					output.FormatBytes(forecast.Size),
+					output.ColorizePercentage(forecast.UsedPercent(), false),
+					growth,
+					days,
+					fullBy,
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+			fmt.Printf("\nBased on %d sample(s) from the last %d days.\n", len(samples), forecastDays)
+		} else {
+			formatter.Print(forecasts)
+		}
+
+		return nil
+	},
+}
+
+// forecastCollectCmd represents the forecast collect command
+var forecastCollectCmd = &cobra.Command{
+	Use:   "collect",
+	Short: "Record a usage sample",
+	Long: `Record the current array, disk, and share usage to the local history store
+(~/.unraidcli/history/). Run this periodically, for example hourly from cron:
+
+  0 * * * * unraidcli forecast collect`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		arrayInfo, err := apiClient.GetArrayInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get array info: %w", err)
+		}
+
+		shares, err := apiClient.GetShares(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get shares: %w", err)
+		}
+
+		sample := history.Sample{
+			Time: time.Now().UTC(),
+			Array: history.Usage{
+				Used: arrayInfo.Capacity.Kilobytes.Used.Bytes(),
+				Size: arrayInfo.Capacity.Kilobytes.Total.Bytes(),
+			},
+			Disks:  make(map[string]history.Usage),
+			Shares: make(map[string]history.Usage),
+		}
+
+		disks := append([]client.ArrayDisk{}, arrayInfo.Disks...)
+		disks = append(disks, arrayInfo.Caches...)
+
+		for _, disk := range disks {
+			if disk.FsSize <= 0 {
+				continue
+			}
+			sample.Disks[disk.Name] = history.Usage{
+				Used: disk.FsUsed.Bytes(),
+				Size: disk.FsSize.Bytes(),
+			}
+		}
+
+		for _, share := range shares {
+			// A share can grow into its free space, so that is its capacity
+			sample.Shares[share.Name] = history.Usage{
+				Used: share.Used,
+				Size: share.Used + share.Free,
+			}
+		}
+
+		server, err := currentServerName()
+		if err != nil {
+			return err
+		}
+
+		store, err := history.Open(server)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Append(sample); err != nil {
+			return err
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Recorded usage sample (%d disk(s), %d share(s)) to %s\n", len(sample.Disks), len(sample.Shares), store.Path())
+		} else {
+			formatter.Print(sample)
+		}
+
+		return nil
+	},
+}
+
+// currentServerName returns the name of the selected server profile
+func currentServerName() (string, error) {
+	name := serverName
+	if name == "" {
+		name = cfg.DefaultServer
+	}
+	if name == "" {
+		return "", fmt.Errorf("no server specified and no default server configured")
+	}
+	return name, nil
+}
+
+// colorizeDaysUntilFull colors a days-until-full estimate by urgency
+func colorizeDaysUntilFull(days float64) string {
+	if days <= 0 {
+		return output.Red("full")
+	}
+
+	text := fmt.Sprintf("%.0f", days)
+	if days < 30 {
+		return output.Red(text)
+	} else if days < 90 {
+		return output.Yellow(text)
+	}
+	return output.Green(text)
+}
+
+func init() {
+	rootCmd.AddCommand(forecastCmd)
+	forecastCmd.AddCommand(forecastCollectCmd)
+
+	forecastCmd.Flags().IntVar(&forecastDays, "days", 90, "Number of days of history to fit the trend to")
+	forecastCmd.Flags().StringVar(&forecastKind, "kind", "", "Only show one kind: array, disk, or share")
+}
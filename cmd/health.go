@@ -14,7 +14,7 @@ import (
 var healthCmd = &cobra.Command{
 	Use:   "health",
 	Short: "System health overview",
-	Long:  "Display a quick overview of overall system health including array, disks, Docker, VMs, and parity status.",
+	Long:  "Display a quick overview of overall system health including array, disks, Docker, VMs, parity, and UPS status.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -151,6 +151,32 @@ var healthCmd = &cobra.Command{
 
 		fmt.Println()
 
+		// Check UPS, if one is configured. A UPS is optional, so failing to
+		// query it is only a warning.
+		upsDevices, err := apiClient.GetUPSDevices(ctx)
+		if err != nil {
+			fmt.Println("=== UPS ===")
+			fmt.Printf("%s\n", output.Warning("Could not get UPS status: "+err.Error()))
+			hasWarnings = true
+			fmt.Println()
+		} else if len(upsDevices) > 0 {
+			fmt.Println("=== UPS ===")
+			for _, device := range upsDevices {
+				summary := fmt.Sprintf("%d%% charge, %s runtime, %.0f%% load",
+					device.Battery.ChargeLevel,
+					time.Duration(device.Battery.EstimatedRuntime)*time.Second,
+					device.Power.LoadPercentage)
+
+				if warnings := upsWarnings(device); len(warnings) > 0 {
+					fmt.Printf("%s: %s (%s)\n", device.Name, output.Warning(strings.Join(warnings, ", ")), summary)
+					hasWarnings = true
+				} else {
+					fmt.Printf("%s: %s (%s)\n", device.Name, output.Success("On line power"), summary)
+				}
+			}
+			fmt.Println()
+		}
+
 		// Check Notifications
 		fmt.Println("=== Notifications ===")
 		notifOverview, err := apiClient.GetNotificationOverview(ctx)
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	moverWatch    bool
+	moverInterval int
+	moverCron     string
+	moverDisable  bool
+)
+
+// moverCmd represents the mover command
+var moverCmd = &cobra.Command{
+	Use:   "mover",
+	Short: "Control the mover",
+	Long:  "Start the mover, which moves share data between cache pools and the array, and manage its schedule.",
+}
+
+// moverStartCmd represents the mover start command
+var moverStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start the mover",
+	Long:  "Start moving share data between cache pools and the array now.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		status, err := apiClient.GetMoverStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get mover status: %w", err)
+		}
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		if status.Running {
+			if table {
+				fmt.Println("Mover is already running")
+			} else {
+				formatter.Print(map[string]string{
+					"status":  "success",
+					"message": "Mover is already running",
+				})
+			}
+			return nil
+		}
+
+		if table {
+			fmt.Println("Starting mover...")
+		}
+
+		if err := apiClient.StartMover(ctx); err != nil {
+			return fmt.Errorf("failed to start mover: %w", err)
+		}
+
+		if table {
+			fmt.Println("✓ Mover started successfully")
+			fmt.Println("Use 'unraidcli mover status --watch' to follow progress")
+		} else {
+			formatter.Print(map[string]string{
+				"status":  "success",
+				"message": "Mover started successfully",
+			})
+		}
+		return nil
+	},
+}
+
+// moverStatusCmd represents the mover status command
+var moverStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show mover status",
+	Long: `Show whether the mover is running and how much share data is still on cache.
+
+With --watch, the status is refreshed every --interval seconds until the
+mover finishes.
+
+Examples:
+  unraidcli mover status
+  unraidcli mover status --watch`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sawRunning := false
+
+		statusFunc := func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			status, err := apiClient.GetMoverStatus(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get mover status: %w", err)
+			}
+
+			shares, err := apiClient.GetShareSettings(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get shares: %w", err)
+			}
+
+			if outputFormat == "" || outputFormat == "table" {
+				if moverWatch {
+					fmt.Printf("Last updated: %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
+				}
+				printMoverStatus(status, shares)
+			} else {
+				formatter.Print(status)
+			}
+
+			if status.Running {
+				sawRunning = true
+			} else if moverWatch {
+				return output.ErrStopWatch
+			}
+
+			return nil
+		}
+
+		if !moverWatch {
+			return statusFunc()
+		}
+
+		if moverInterval < 1 {
+			return fmt.Errorf("--interval must be at least 1 second")
+		}
+
+		// Setup signal handling for graceful exit
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigChan
+			cancel()
+		}()
+
+		interval := time.Duration(moverInterval) * time.Second
+		if err := output.Watch(ctx, interval, statusFunc); err != nil {
+			return err
+		}
+
+		if sawRunning && ctx.Err() == nil && (outputFormat == "" || outputFormat == "table") {
+			fmt.Printf("\n%s\n", output.Success("Mover finished"))
+		}
+
+		return nil
+	},
+}
+
+// printMoverStatus prints the mover status and the data waiting on cache
+func printMoverStatus(status *client.MoverStatus, shares []client.Share) {
+	if status.Running {
+		fmt.Printf("Status: %s\n", output.ColorizeState("running"))
+		if status.StartedAt != "" {
+			fmt.Printf("Started: %s\n", status.StartedAt)
+		}
+	} else {
+		fmt.Printf("Status: %s\n", output.Gray("idle"))
+		if status.FinishedAt != "" {
+			fmt.Printf("Last Finished: %s\n", status.FinishedAt)
+		}
+	}
+
+	if status.Schedule != "" {
+		fmt.Printf("Schedule: %s\n", status.Schedule)
+	} else {
+		fmt.Printf("Schedule: disabled\n")
+	}
+
+	// Shares set to "yes" are moved from cache to the array, "prefer" shares
+	// from the array to cache
+	var toArray int64
+	for _, share := range shares {
+		if strings.EqualFold(share.UseCache, "yes") {
+			toArray += share.CacheUsed
+		}
+	}
+	fmt.Printf("On Cache (to move to array): %s\n", output.FormatBytes(toArray))
+}
+
+// moverScheduleCmd represents the mover schedule command
+var moverScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Show or change the mover schedule",
+	Long: `Show the mover schedule, or change it with --cron (a standard five-field
+cron expression) or --disable.
+
+Examples:
+  unraidcli mover schedule
+  unraidcli mover schedule --cron "40 3 * * *"
+  unraidcli mover schedule --disable`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if cmd.Flags().Changed("cron") && moverDisable {
+			return fmt.Errorf("--cron and --disable cannot be used together")
+		}
+
+		if cmd.Flags().Changed("cron") || moverDisable {
+			schedule := ""
+			if !moverDisable {
+				if fields := strings.Fields(moverCron); len(fields) != 5 {
+					return fmt.Errorf("invalid cron expression %q (expected 5 fields)", moverCron)
+				}
+				schedule = strings.Join(strings.Fields(moverCron), " ")
+			}
+
+			if err := apiClient.SetMoverSchedule(ctx, schedule); err != nil {
+				return fmt.Errorf("failed to set mover schedule: %w", err)
+			}
+
+			message := "Scheduled mover runs disabled"
+			if schedule != "" {
+				message = fmt.Sprintf("Mover schedule set to '%s'", schedule)
+			}
+
+			if outputFormat == "" || outputFormat == "table" {
+				fmt.Printf("✓ %s\n", message)
+			} else {
+				formatter.Print(map[string]string{
+					"status":   "success",
+					"message":  message,
+					"schedule": schedule,
+				})
+			}
+			return nil
+		}
+
+		status, err := apiClient.GetMoverStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get mover status: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			if status.Schedule != "" {
+				fmt.Printf("Schedule: %s\n", status.Schedule)
+			} else {
+				fmt.Printf("Schedule: disabled\n")
+			}
+		} else {
+			formatter.Print(map[string]string{"schedule": status.Schedule})
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(moverCmd)
+	moverCmd.AddCommand(moverStartCmd)
+	moverCmd.AddCommand(moverStatusCmd)
+	moverCmd.AddCommand(moverScheduleCmd)
+
+	// Add flags
+	moverStatusCmd.Flags().BoolVarP(&moverWatch, "watch", "w", false, "Watch mode - refresh until the mover finishes")
+	moverStatusCmd.Flags().IntVarP(&moverInterval, "interval", "i", 5, "Refresh interval in seconds for watch mode")
+	moverScheduleCmd.Flags().StringVar(&moverCron, "cron", "", "New schedule as a cron expression (e.g. \"40 3 * * *\")")
+	moverScheduleCmd.Flags().BoolVar(&moverDisable, "disable", false, "Disable scheduled mover runs")
+}
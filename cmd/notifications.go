@@ -2,15 +2,42 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
 	"github.com/spf13/cobra"
 )
 
 var (
-	notifImportance string
-	notifLimit      int
+	notifImportance  string
+	notifLimit       int
+	notifPage        int
+	notifListAll     bool
+	notifAll         bool
+	notifOlderThan   string
+	notifAllArchived bool
+	notifYes         bool
+
+	sendTitle       string
+	sendSubject     string
+	sendDescription string
+	sendImportance  string
+	sendLink        string
+
+	notifWatchImportance []string
+	notifWatchMatch      string
+	notifWatchExisting   bool
+	notifWatchInterval   int
 )
 
 // notificationsCmd represents the notifications command
@@ -31,9 +58,9 @@ var notificationsListCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		notifications, err := apiClient.GetNotifications(ctx, "UNREAD", notifImportance, 0, notifLimit)
+		notifications, err := listNotifications(ctx, "UNREAD")
 		if err != nil {
-			return fmt.Errorf("failed to get notifications: %w", err)
+			return err
 		}
 
 		if len(notifications) == 0 {
@@ -48,7 +75,7 @@ var notificationsListCmd = &cobra.Command{
 				}
 
 				// Format importance with indicator
-				importance := notif.Importance
+				importance := output.ColorizeImportance(notif.Importance)
 				switch notif.Importance {
 				case "ALERT":
 					importance = "🔴 " + importance
@@ -70,6 +97,7 @@ var notificationsListCmd = &cobra.Command{
 					fmt.Printf("Link: %s\n", notif.Link)
 				}
 			}
+			printPageHint(len(notifications))
 		} else {
 			formatter.Print(notifications)
 		}
@@ -87,9 +115,9 @@ var notificationsArchiveCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		notifications, err := apiClient.GetNotifications(ctx, "ARCHIVE", notifImportance, 0, notifLimit)
+		notifications, err := listNotifications(ctx, "ARCHIVE")
 		if err != nil {
-			return fmt.Errorf("failed to get notifications: %w", err)
+			return err
 		}
 
 		if len(notifications) == 0 {
@@ -103,7 +131,7 @@ var notificationsArchiveCmd = &cobra.Command{
 
 			for _, notif := range notifications {
 				rows = append(rows, []string{
-					notif.Importance,
+					output.ColorizeImportance(notif.Importance),
 					notif.Title,
 					notif.Subject,
 					notif.Timestamp,
@@ -111,6 +139,7 @@ var notificationsArchiveCmd = &cobra.Command{
 			}
 
 			formatter.PrintTable(headers, rows)
+			printPageHint(len(notifications))
 		} else {
 			formatter.Print(notifications)
 		}
@@ -119,6 +148,180 @@ var notificationsArchiveCmd = &cobra.Command{
 	},
 }
 
+// notificationsWatchCmd represents the notifications watch command
+var notificationsWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Follow new notifications as they arrive",
+	Long: `Print new unread notifications as they arrive, colored by importance,
+until interrupted with Ctrl+C.
+
+Notifications can be filtered by importance and by a regular expression
+matched against the title and subject. With -o json, each notification is
+printed as one line of JSON (NDJSON), for piping into other tools.
+
+Examples:
+  unraidcli notifications watch
+  unraidcli notifications watch --importance ALERT,WARNING
+  unraidcli notifications watch --match "(?i)parity|smart"
+  unraidcli notifications watch -o json | jq -r .subject`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if notifWatchInterval < 1 {
+			return fmt.Errorf("--interval must be at least 1 second")
+		}
+
+		importances := make(map[string]bool)
+		for _, importance := range notifWatchImportance {
+			importances[strings.ToUpper(strings.TrimSpace(importance))] = true
+		}
+
+		var pattern *regexp.Regexp
+		if notifWatchMatch != "" {
+			var err error
+			if pattern, err = regexp.Compile(notifWatchMatch); err != nil {
+				return fmt.Errorf("invalid --match expression: %w", err)
+			}
+		}
+
+		matches := func(notif client.Notification) bool {
+			if len(importances) > 0 && !importances[strings.ToUpper(notif.Importance)] {
+				return false
+			}
+			return pattern == nil || pattern.MatchString(notif.Title) || pattern.MatchString(notif.Subject)
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		emit := func(notif client.Notification) error {
+			switch outputFormat {
+			case "", "table":
+				printNotificationCard(notif)
+				return nil
+			case "json":
+				return encoder.Encode(notif)
+			default:
+				return formatter.Print(notif)
+			}
+		}
+
+		// Setup signal handling for graceful exit
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigChan
+			cancel()
+		}()
+
+		seen := make(map[string]bool)
+		first := true
+
+		poll := func() error {
+			reqCtx, reqCancel := context.WithTimeout(ctx, 30*time.Second)
+			defer reqCancel()
+
+			notifications, err := apiClient.GetAllNotifications(reqCtx, "UNREAD", "")
+			if err != nil {
+				return fmt.Errorf("failed to get notifications: %w", err)
+			}
+
+			// The API lists newest first; print oldest first
+			for i := len(notifications) - 1; i >= 0; i-- {
+				notif := notifications[i]
+				if seen[notif.ID] {
+					continue
+				}
+				seen[notif.ID] = true
+
+				if (first && !notifWatchExisting) || !matches(notif) {
+					continue
+				}
+				if err := emit(notif); err != nil {
+					return err
+				}
+			}
+
+			first = false
+			return nil
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Println(output.Gray("Waiting for notifications (Ctrl+C to stop)..."))
+		}
+
+		if err := poll(); err != nil {
+			return err
+		}
+
+		ticker := time.NewTicker(time.Duration(notifWatchInterval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				if err := poll(); err != nil {
+					// Keep following through temporary connection problems
+					fmt.Fprintln(os.Stderr, output.Error(err.Error()))
+				}
+			}
+		}
+	},
+}
+
+// printNotificationCard prints a notification as a bordered card
+func printNotificationCard(notif client.Notification) {
+	border := output.Gray("│")
+
+	fmt.Println()
+	fmt.Printf("%s %s %s\n", output.Gray("┌"), output.ColorizeImportance(notif.Importance), notif.Title)
+	fmt.Printf("%s %s\n", border, notif.Subject)
+	for _, line := range strings.Split(strings.TrimSpace(notif.Description), "\n") {
+		if line != "" {
+			fmt.Printf("%s %s\n", border, output.Gray(line))
+		}
+	}
+	if notif.Link != "" {
+		fmt.Printf("%s %s\n", border, output.Cyan(notif.Link))
+	}
+	fmt.Printf("%s %s\n", output.Gray("└"), output.Gray(notif.Timestamp))
+}
+
+// listNotifications returns the requested page of notifications of a type,
+// or all of them with --all
+func listNotifications(ctx context.Context, notifType string) ([]client.Notification, error) {
+	importance := strings.ToUpper(notifImportance)
+
+	if notifListAll {
+		notifications, err := apiClient.GetAllNotifications(ctx, notifType, importance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifications: %w", err)
+		}
+		return notifications, nil
+	}
+
+	if notifPage < 1 {
+		return nil, fmt.Errorf("--page must be 1 or greater")
+	}
+	if notifLimit < 1 {
+		return nil, fmt.Errorf("--limit must be 1 or greater")
+	}
+
+	notifications, err := apiClient.GetNotifications(ctx, notifType, importance, (notifPage-1)*notifLimit, notifLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// printPageHint points to the next page when the current one is full
+func printPageHint(count int) {
+	if !notifListAll && count == notifLimit {
+		fmt.Printf("\n%s\n", output.Gray(fmt.Sprintf("Page %d. Use --page %d for more, or --all.", notifPage, notifPage+1)))
+	}
+}
+
 // notificationsOverviewCmd represents the notifications overview command
 var notificationsOverviewCmd = &cobra.Command{
 	Use:   "overview",
@@ -153,16 +356,357 @@ var notificationsOverviewCmd = &cobra.Command{
 	},
 }
 
+// notificationsAckCmd represents the notifications ack command
+var notificationsAckCmd = &cobra.Command{
+	Use:     "ack [id...]",
+	Aliases: []string{"dismiss"},
+	Short:   "Archive (acknowledge) notifications",
+	Long: `Archive unread notifications by ID, or all unread notifications with --all.
+--all can be narrowed down with --importance and --older-than (e.g. 12h, 7d, 2w).
+
+Examples:
+  unraidcli notifications ack 1a2b3c 4d5e6f
+  unraidcli notifications ack --all
+  unraidcli notifications ack --all --importance INFO --older-than 7d`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		if len(args) > 0 && notifAll {
+			return fmt.Errorf("specify notification IDs or --all, not both")
+		}
+		if len(args) == 0 && !notifAll {
+			return fmt.Errorf("specify notification IDs or --all")
+		}
+		if len(args) > 0 && (notifOlderThan != "" || notifImportance != "") {
+			return fmt.Errorf("--importance and --older-than can only be used with --all")
+		}
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		if len(args) > 0 {
+			if err := apiClient.ArchiveNotifications(ctx, args); err != nil {
+				return fmt.Errorf("failed to archive notifications: %w", err)
+			}
+			printNotificationsResult(fmt.Sprintf("Archived %d notification(s)", len(args)), args)
+			return nil
+		}
+
+		importance := strings.ToUpper(notifImportance)
+
+		// Without an age filter the server can archive everything in one call
+		if notifOlderThan == "" {
+			if err := apiClient.ArchiveAllNotifications(ctx, importance); err != nil {
+				return fmt.Errorf("failed to archive notifications: %w", err)
+			}
+			if table {
+				fmt.Println("✓ Archived all unread notifications")
+			} else {
+				formatter.Print(map[string]interface{}{
+					"status":     "success",
+					"message":    "Archived all unread notifications",
+					"importance": importance,
+				})
+			}
+			return nil
+		}
+
+		age, err := parseAge(notifOlderThan)
+		if err != nil {
+			return err
+		}
+		cutoff := time.Now().Add(-age)
+
+		notifications, err := apiClient.GetAllNotifications(ctx, "UNREAD", importance)
+		if err != nil {
+			return fmt.Errorf("failed to get notifications: %w", err)
+		}
+
+		var ids []string
+		skipped := 0
+		for _, notif := range notifications {
+			t, err := notif.Time()
+			if err != nil {
+				skipped++
+				continue
+			}
+			if t.Before(cutoff) {
+				ids = append(ids, notif.ID)
+			}
+		}
+
+		if skipped > 0 {
+			fmt.Fprintln(os.Stderr, output.Warning(fmt.Sprintf("Skipped %d notification(s) with an unrecognized timestamp", skipped)))
+		}
+
+		if len(ids) == 0 {
+			if table {
+				fmt.Println("No matching notifications to archive.")
+			} else {
+				printNotificationsResult("No matching notifications to archive", []string{})
+			}
+			return nil
+		}
+
+		if err := apiClient.ArchiveNotifications(ctx, ids); err != nil {
+			return fmt.Errorf("failed to archive notifications: %w", err)
+		}
+
+		printNotificationsResult(fmt.Sprintf("Archived %d notification(s)", len(ids)), ids)
+		return nil
+	},
+}
+
+// notificationsUnarchiveCmd represents the notifications unarchive command
+var notificationsUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive <id...>",
+	Short: "Mark archived notifications as unread",
+	Long:  "Move archived notifications back to the unread list.",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := apiClient.UnarchiveNotifications(ctx, args); err != nil {
+			return fmt.Errorf("failed to unarchive notifications: %w", err)
+		}
+
+		printNotificationsResult(fmt.Sprintf("Unarchived %d notification(s)", len(args)), args)
+		return nil
+	},
+}
+
+// notificationsDeleteCmd represents the notifications delete command
+var notificationsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Permanently delete notifications",
+	Long: `Permanently delete notifications by ID, or all archived notifications with
+--all-archived. Deleting all archived notifications asks for confirmation
+unless --yes is given.
+
+Examples:
+  unraidcli notifications delete 1a2b3c
+  unraidcli notifications delete --all-archived`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 && notifAllArchived {
+			return fmt.Errorf("specify notification IDs or --all-archived, not both")
+		}
+		if len(args) == 0 && !notifAllArchived {
+			return fmt.Errorf("specify notification IDs or --all-archived")
+		}
+
+		if notifAllArchived && !notifYes {
+			if err := confirmTyped("delete all archived notifications", "delete"); err != nil {
+				return err
+			}
+		}
+
+		// Created after the confirmation prompt, which may wait indefinitely
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		if notifAllArchived {
+			if err := apiClient.DeleteArchivedNotifications(ctx); err != nil {
+				return fmt.Errorf("failed to delete archived notifications: %w", err)
+			}
+
+			if table {
+				fmt.Println("✓ Deleted all archived notifications")
+			} else {
+				formatter.Print(map[string]string{
+					"status":  "success",
+					"message": "Deleted all archived notifications",
+				})
+			}
+			return nil
+		}
+
+		// Deleting requires the notification's type, so look the IDs up
+		types := make(map[string]string)
+		for _, notifType := range []string{"UNREAD", "ARCHIVE"} {
+			notifications, err := apiClient.GetAllNotifications(ctx, notifType, "")
+			if err != nil {
+				return fmt.Errorf("failed to get notifications: %w", err)
+			}
+			for _, notif := range notifications {
+				types[notif.ID] = notifType
+			}
+		}
+
+		var failed []string
+		for _, id := range args {
+			if table {
+				fmt.Printf("  Deleting notification '%s'... ", id)
+			}
+
+			notifType, ok := types[id]
+			if !ok {
+				if table {
+					fmt.Printf("✗ Failed: not found\n")
+				}
+				failed = append(failed, id)
+				continue
+			}
+
+			if err := apiClient.DeleteNotification(ctx, id, notifType); err != nil {
+				if table {
+					fmt.Printf("✗ Failed: %v\n", err)
+				}
+				failed = append(failed, id)
+			} else if table {
+				fmt.Printf("✓\n")
+			}
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to delete %d notification(s): %v", len(failed), failed)
+		}
+
+		if !table {
+			printNotificationsResult(fmt.Sprintf("Deleted %d notification(s)", len(args)), args)
+		}
+
+		return nil
+	},
+}
+
+// notificationsSendCmd represents the notifications send command
+var notificationsSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Create a notification",
+	Long: `Create a notification in the server's notification center, e.g. from a
+backup script or cron job.
+
+The description is read from standard input when --description is "-".
+
+Examples:
+  unraidcli notifications send --title Backup --subject "Nightly backup done"
+  unraidcli notifications send --title Backup --subject "Backup failed" --importance ALERT --description "rsync exited with 23"
+  rsync -a /mnt/user/data backup:/data 2>&1 | tail -20 | unraidcli notifications send --title Backup --subject "rsync output" --importance WARNING --description -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if sendTitle == "" || sendSubject == "" {
+			return fmt.Errorf("--title and --subject are required")
+		}
+
+		importance := strings.ToUpper(sendImportance)
+		switch importance {
+		case "ALERT", "WARNING", "INFO":
+		default:
+			return fmt.Errorf("invalid importance %q (use ALERT, WARNING, or INFO)", sendImportance)
+		}
+
+		description := sendDescription
+		if description == "-" {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("failed to read description from stdin: %w", err)
+			}
+			description = strings.TrimRight(string(data), "\r\n")
+		}
+
+		// Created after reading stdin, which may take as long as the
+		// producing command runs
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		notification, err := apiClient.CreateNotification(ctx, client.NotificationInput{
+			Title:       sendTitle,
+			Subject:     sendSubject,
+			Description: description,
+			Importance:  importance,
+			Link:        sendLink,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Notification created (ID: %s)\n", notification.ID)
+		} else {
+			formatter.Print(notification)
+		}
+
+		return nil
+	},
+}
+
+// printNotificationsResult prints the result of an action on the
+// notifications with the given IDs
+func printNotificationsResult(message string, ids []string) {
+	if outputFormat == "" || outputFormat == "table" {
+		fmt.Printf("✓ %s\n", message)
+		return
+	}
+
+	formatter.Print(map[string]interface{}{
+		"status":  "success",
+		"message": message,
+		"count":   len(ids),
+		"ids":     ids,
+	})
+}
+
+// parseAge parses an age such as "7d", "2w" or "12h". Days and weeks are
+// supported in addition to the units of time.ParseDuration.
+func parseAge(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			n, err := strconv.ParseFloat(number, 64)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid age %q", value)
+			}
+			return time.Duration(n * float64(unit)), nil
+		}
+	}
+
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q (e.g. 12h, 7d, 2w)", value)
+	}
+	return age, nil
+}
+
 func init() {
 	rootCmd.AddCommand(notificationsCmd)
 	notificationsCmd.AddCommand(notificationsListCmd)
 	notificationsCmd.AddCommand(notificationsArchiveCmd)
 	notificationsCmd.AddCommand(notificationsOverviewCmd)
+	notificationsCmd.AddCommand(notificationsAckCmd)
+	notificationsCmd.AddCommand(notificationsUnarchiveCmd)
+	notificationsCmd.AddCommand(notificationsDeleteCmd)
+	notificationsCmd.AddCommand(notificationsSendCmd)
+	notificationsCmd.AddCommand(notificationsWatchCmd)
 
 	// Add flags
 	notificationsListCmd.Flags().StringVar(&notifImportance, "importance", "", "Filter by importance: ALERT, WARNING, INFO")
 	notificationsListCmd.Flags().IntVar(&notifLimit, "limit", 20, "Maximum number of notifications to display")
+	notificationsListCmd.Flags().IntVar(&notifPage, "page", 1, "Page to display, with --limit notifications per page")
+	notificationsListCmd.Flags().BoolVar(&notifListAll, "all", false, "Display all notifications")
 
 	notificationsArchiveCmd.Flags().StringVar(&notifImportance, "importance", "", "Filter by importance: ALERT, WARNING, INFO")
 	notificationsArchiveCmd.Flags().IntVar(&notifLimit, "limit", 20, "Maximum number of notifications to display")
+	notificationsArchiveCmd.Flags().IntVar(&notifPage, "page", 1, "Page to display, with --limit notifications per page")
+	notificationsArchiveCmd.Flags().BoolVar(&notifListAll, "all", false, "Display all notifications")
+
+	notificationsAckCmd.Flags().BoolVar(&notifAll, "all", false, "Archive all unread notifications")
+	notificationsAckCmd.Flags().StringVar(&notifImportance, "importance", "", "With --all, only archive notifications of this importance: ALERT, WARNING, INFO")
+	notificationsAckCmd.Flags().StringVar(&notifOlderThan, "older-than", "", "With --all, only archive notifications older than this (e.g. 12h, 7d, 2w)")
+
+	notificationsDeleteCmd.Flags().BoolVar(&notifAllArchived, "all-archived", false, "Delete all archived notifications")
+	notificationsDeleteCmd.Flags().BoolVarP(&notifYes, "yes", "y", false, "Skip the confirmation prompt")
+
+	notificationsWatchCmd.Flags().StringSliceVar(&notifWatchImportance, "importance", nil, "Only show these importances (e.g. ALERT,WARNING)")
+	notificationsWatchCmd.Flags().StringVar(&notifWatchMatch, "match", "", "Only show notifications whose title or subject matches this regular expression")
+	notificationsWatchCmd.Flags().BoolVar(&notifWatchExisting, "existing", false, "Also show notifications that are already unread")
+	notificationsWatchCmd.Flags().IntVarP(&notifWatchInterval, "interval", "i", 5, "Polling interval in seconds")
+
+	notificationsSendCmd.Flags().StringVar(&sendTitle, "title", "", "Notification title (e.g. the sending script)")
+	notificationsSendCmd.Flags().StringVar(&sendSubject, "subject", "", "Notification subject")
+	notificationsSendCmd.Flags().StringVar(&sendDescription, "description", "", "Notification description (\"-\" to read from stdin)")
+	notificationsSendCmd.Flags().StringVar(&sendImportance, "importance", "INFO", "Importance: ALERT, WARNING, INFO")
+	notificationsSendCmd.Flags().StringVar(&sendLink, "link", "", "Link to open from the notification")
 }
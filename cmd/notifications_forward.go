@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/forward"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	forwardOnce     bool
+	forwardBacklog  bool
+	forwardTest     bool
+	forwardInterval int
+)
+
+// defaultForwardInterval is the polling interval when none is configured
+const defaultForwardInterval = 30
+
+// notificationsForwardCmd represents the notifications forward command
+var notificationsForwardCmd = &cobra.Command{
+	Use:   "forward",
+	Short: "Forward new notifications to webhooks, ntfy, Gotify, Slack, Discord, or email",
+	Long: `Run in the foreground, polling for new unread notifications and pushing each
+one to the sinks configured in the "forward" section of config.yaml.
+
+The newest forwarded notification is remembered in ~/.unraidcli/forward/, so
+restarts do not resend notifications. On the very first run, existing unread
+notifications are skipped unless --backlog is given. Failed deliveries are
+retried with exponential backoff. A notification that a sink still does not
+accept is queued and redelivered on later polls, waiting 1 minute after the
+first failure and doubling up to 1 hour, and dropped after 8 redeliveries.
+At most 100 notifications are queued; the oldest are dropped first. A sink
+that fails is not tried again until the next poll. Sink names must be
+unique.
+
+Use --test to send a test message to every sink, and --once to poll a single
+time (e.g. from cron).
+
+Examples:
+  unraidcli notifications forward
+  unraidcli notifications forward --test
+  unraidcli notifications forward --once --backlog`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings := cfg.Forward
+		if len(settings.Sinks) == 0 {
+			return fmt.Errorf("no sinks configured; add a 'forward' section to the config file (see README)")
+		}
+
+		targets, err := forward.NewTargets(settings.Sinks)
+		if err != nil {
+			return err
+		}
+
+		retries := settings.Retries
+		if retries == 0 {
+			retries = forward.DefaultRetries
+		}
+
+		server, err := currentServerName()
+		if err != nil {
+			return err
+		}
+
+		if forwardTest {
+			return sendForwardTest(targets, server)
+		}
+
+		state, ok, err := forward.LoadState(server)
+		if err != nil {
+			return err
+		}
+
+		// Setup signal handling for graceful exit
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigChan
+			cancel()
+		}()
+
+		f := &forwarder{
+			targets: targets,
+			retries: retries,
+			server:  server,
+			state:   state,
+			started: ok || forwardBacklog,
+			warned:  make(map[string]bool),
+		}
+
+		if forwardOnce {
+			return f.poll(ctx)
+		}
+
+		interval := forwardInterval
+		if interval == 0 {
+			interval = settings.Interval
+		}
+		if interval == 0 {
+			interval = defaultForwardInterval
+		}
+		if interval < 1 {
+			return fmt.Errorf("the polling interval must be at least 1 second")
+		}
+
+		logf("Forwarding notifications from '%s' to %d sink(s) every %ds (Ctrl+C to stop)", server, len(targets), interval)
+
+		if err := f.poll(ctx); err != nil {
+			logf("%s", output.Error(err.Error()))
+		}
+
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				logf("Stopped")
+				return nil
+			case <-ticker.C:
+				if err := f.poll(ctx); err != nil {
+					logf("%s", output.Error(err.Error()))
+				}
+			}
+		}
+	},
+}
+
+// forwarder pushes new unread notifications to its targets
+type forwarder struct {
+	targets []*forward.Target
+	retries int
+	server  string
+	state   *forward.State
+
+	// started is false until the cursor has been initialized
+	started bool
+
+	// warned holds IDs of notifications with unparseable timestamps that
+	// have already been reported
+	warned map[string]bool
+
+	// down holds the names of targets that failed during the current poll
+	down map[string]bool
+}
+
+// timedNotification is a notification with its parsed timestamp
+type timedNotification struct {
+	client.Notification
+	at time.Time
+}
+
+// poll fetches unread notifications and forwards the ones not seen before
+func (f *forwarder) poll(ctx context.Context) error {
+	reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	notifications, err := apiClient.GetAllNotifications(reqCtx, "UNREAD", "")
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	var pending []timedNotification
+	for _, notif := range notifications {
+		at, err := notif.Time()
+		if err != nil {
+			if !f.warned[notif.ID] {
+				logf("%s", output.Warning(fmt.Sprintf("Skipping notification %s: %v", notif.ID, err)))
+				f.warned[notif.ID] = true
+			}
+			continue
+		}
+		if !f.state.Seen(at, notif.ID) {
+			pending = append(pending, timedNotification{Notification: notif, at: at})
+		}
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].at.Before(pending[j].at)
+	})
+
+	// On the first run, start from the newest existing notification
+	if !f.started {
+		for _, notif := range pending {
+			f.state.Mark(notif.at, notif.ID)
+		}
+		if err := f.state.Save(); err != nil {
+			return err
+		}
+		f.started = true
+		if len(pending) > 0 {
+			logf("Skipped %d existing unread notification(s) (use --backlog to forward them)", len(pending))
+		}
+		return nil
+	}
+
+	f.down = make(map[string]bool)
+
+	if err := f.retry(ctx); err != nil {
+		return err
+	}
+
+	for _, notif := range pending {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		msg := f.message(notif.Notification)
+		failed := f.deliver(ctx, msg, nil, f.retries)
+
+		// Sinks that are still failing get the message again on later polls,
+		// so the cursor can move on without resending to the others
+		if len(failed) > 0 {
+			for _, dropped := range f.state.QueueRetry(msg, failed, time.Now()) {
+				logf("%s", output.Warning(fmt.Sprintf("Dropped %s for %v: too many queued notifications", dropped.Message.Subject, dropped.Sinks)))
+			}
+		}
+
+		f.state.Mark(notif.at, notif.ID)
+		if err := f.state.Save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retry redelivers the queued messages that are due to the sinks that
+// failed on earlier polls, once each. Messages that have used up their
+// attempts are dropped.
+func (f *forwarder) retry(ctx context.Context) error {
+	if len(f.state.Retries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var remaining []forward.Retry
+	for _, retry := range f.state.Retries {
+		if ctx.Err() != nil || !retry.Due(now) {
+			remaining = append(remaining, retry)
+			continue
+		}
+
+		failed := f.deliver(ctx, retry.Message, retry.Sinks, 0)
+		if len(failed) == 0 {
+			continue
+		}
+
+		if retry.Failed(failed, now) {
+			remaining = append(remaining, retry)
+		} else {
+			logf("%s", output.Error(fmt.Sprintf("Giving up on %s for %v after %d attempts", retry.Message.Subject, failed, retry.Attempts)))
+		}
+	}
+
+	f.state.Retries = remaining
+	return f.state.Save()
+}
+
+// message converts a notification into a message to forward
+func (f *forwarder) message(notif client.Notification) forward.Message {
+	return forward.Message{
+		ID:          notif.ID,
+		Server:      f.server,
+		Title:       notif.Title,
+		Subject:     notif.Subject,
+		Description: notif.Description,
+		Importance:  notif.Importance,
+		Link:        notif.Link,
+		Timestamp:   notif.Timestamp,
+	}
+}
+
+// deliver sends a message to every target whose filter accepts it, limited
+// to the named targets if only is not nil. Targets that already failed during
+// this poll are skipped so a dead sink cannot stall forwarding. It returns the
+// names of the targets that failed with an error worth retrying.
+func (f *forwarder) deliver(ctx context.Context, msg forward.Message, only []string, retries int) []string {
+	var failed []string
+
+	for _, target := range f.targets {
+		if !target.Accepts(msg) || (only != nil && !slices.Contains(only, target.Name)) {
+			continue
+		}
+
+		if f.down[target.Name] {
+			failed = append(failed, target.Name)
+			continue
+		}
+
+		err := target.Deliver(ctx, msg, retries)
+		if err == nil {
+			logf("✓ [%s] %s → %s", msg.Importance, msg.Subject, target.Name)
+			continue
+		}
+
+		var permanent *forward.PermanentError
+		if errors.As(err, &permanent) {
+			logf("%s", output.Error(fmt.Sprintf("[%s] %s → %s: %v (not retrying)", msg.Importance, msg.Subject, target.Name, err)))
+		} else {
+			logf("%s", output.Error(fmt.Sprintf("[%s] %s → %s: %v (will retry)", msg.Importance, msg.Subject, target.Name, err)))
+			failed = append(failed, target.Name)
+			f.down[target.Name] = true
+		}
+	}
+
+	return failed
+}
+
+// sendForwardTest sends a test message to every target, ignoring filters
+func sendForwardTest(targets []*forward.Target, server string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	msg := forward.Message{
+		ID:          "test",
+		Server:      server,
+		Title:       "unraidcli",
+		Subject:     "Test notification",
+		Description: "If you can read this, notification forwarding works.",
+		Importance:  "INFO",
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+
+	var failed []string
+	for _, target := range targets {
+		fmt.Printf("  Sending test to '%s'... ", target.Name)
+		if err := target.Deliver(ctx, msg, 0); err != nil {
+			fmt.Printf("✗ Failed: %v\n", err)
+			failed = append(failed, target.Name)
+		} else {
+			fmt.Printf("✓\n")
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to send to %d sink(s): %v", len(failed), failed)
+	}
+
+	return nil
+}
+
+func init() {
+	notificationsCmd.AddCommand(notificationsForwardCmd)
+
+	notificationsForwardCmd.Flags().BoolVar(&forwardOnce, "once", false, "Poll once and exit")
+	notificationsForwardCmd.Flags().BoolVar(&forwardBacklog, "backlog", false, "On the first run, also forward existing unread notifications")
+	notificationsForwardCmd.Flags().BoolVar(&forwardTest, "test", false, "Send a test message to every sink and exit")
+	notificationsForwardCmd.Flags().IntVarP(&forwardInterval, "interval", "i", 0, "Polling interval in seconds (default from config or 30)")
+}
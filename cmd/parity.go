@@ -3,12 +3,44 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/01dnot/unraidcli/internal/stats"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
-var correctingErrors bool
+var (
+	correctingErrors bool
+	parityWatch      bool
+	parityInterval   int
+	parityNotify     bool
+
+	parityStatsWindow int
+	parityStatsDrop   float64
+
+	scheduleMode       string
+	scheduleDayOfWeek  string
+	scheduleDayOfMonth int
+	scheduleMonth      string
+	scheduleTime       string
+	scheduleCorrect    bool
+	scheduleCumulative bool
+	scheduleFrequency  int
+	scheduleDuration   int
+
+	windowPauseAt  string
+	windowResumeAt string
+	windowInterval int
+)
 
 // parityCmd represents the parity command
 var parityCmd = &cobra.Command{
@@ -21,57 +53,280 @@ var parityCmd = &cobra.Command{
 var parityStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show parity check status",
-	Long:  "Display current parity check status and progress.",
+	Long: `Display current parity check status and progress.
+
+With --watch, the status is refreshed every --interval seconds and the
+current speed and time remaining are estimated from successive progress
+samples. With --notify-on-complete, the command waits for the running check
+to finish and exits with status 2 if it found errors (0 if it found none).
+If no check is running, it says so and exits with status 0. Without --watch
+it logs progress as plain lines, suitable for scripts and log files, and
+only gives up after 5 status queries in a row have failed.
+
+Examples:
+  unraidcli parity status
+  unraidcli parity status --watch
+  unraidcli parity status --notify-on-complete && echo "parity OK"`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
+		tracker := &progressTracker{}
+		var paritySize int64
+		var sawRunning bool
+		var final *client.ParityCheck
 
-		status, err := apiClient.GetParityCheckStatus(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get parity check status: %w", err)
-		}
+		statusFunc := func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
 
-		if outputFormat == "" || outputFormat == "table" {
-			fmt.Printf("Status: %s\n", status.Status)
+			status, err := apiClient.GetParityCheckStatus(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get parity check status: %w", err)
+			}
+
+			// The parity disk size converts progress into a transfer speed
+			if paritySize == 0 && status.Running && (parityWatch || parityNotify) {
+				if arrayInfo, err := apiClient.GetArrayInfo(ctx); err == nil {
+					for _, disk := range arrayInfo.Parities {
+						if disk.Size > paritySize {
+							paritySize = disk.Size
+						}
+					}
+				}
+			}
 
 			if status.Running {
-				fmt.Printf("Running: ✓ (Progress: %d%%)\n", status.Progress)
+				sawRunning = true
+				tracker.Add(status.Progress)
+			}
+
+			if outputFormat == "" || outputFormat == "table" {
+				if parityWatch || parityNotify {
+					fmt.Printf("Last updated: %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
+				}
+				printParityStatus(status, tracker, paritySize)
 			} else {
-				fmt.Printf("Running: ✗\n")
+				formatter.Print(status)
 			}
 
-			if status.Paused {
-				fmt.Printf("Paused: ✓\n")
+			// Stop watching once the check has finished (or if none is running)
+			if parityNotify && !status.Running {
+				final = status
+				return output.ErrStopWatch
 			}
 
-			if status.Correcting {
-				fmt.Printf("Correcting: ✓\n")
+			return nil
+		}
+
+		if !parityWatch && !parityNotify {
+			return statusFunc()
+		}
+
+		if parityInterval < 1 {
+			return fmt.Errorf("--interval must be at least 1 second")
+		}
+		interval := time.Duration(parityInterval) * time.Second
+
+		// Setup signal handling for graceful exit
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigChan
+			cancel()
+		}()
+
+		if parityWatch {
+			if err := output.Watch(ctx, interval, statusFunc); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			if final, sawRunning, err = waitForParityCheck(ctx, interval); err != nil {
+				return err
+			}
+			if final != nil && outputFormat != "" && outputFormat != "table" {
+				formatter.Print(final)
 			}
+		}
 
-			if status.Date != "" {
-				fmt.Printf("Last Check: %s\n", status.Date)
+		if final != nil {
+			if !sawRunning {
+				fmt.Println("\nNo parity check is running")
+				return nil
 			}
 
-			if status.Duration > 0 {
-				duration := time.Duration(status.Duration) * time.Second
-				fmt.Printf("Duration: %s\n", duration.String())
+			fmt.Printf("\nParity check finished with %d error(s)\n", final.Errors)
+			if final.Errors > 0 {
+				os.Exit(parityErrorsExitCode)
 			}
+		}
 
-			if status.Speed != "" {
-				fmt.Printf("Speed: %s\n", status.Speed)
+		return nil
+	},
+}
+
+// parityNotifyFailures is the number of failed status queries in a row
+// after which --notify-on-complete gives up
+const parityNotifyFailures = 5
+
+// waitForParityCheck polls the parity check status every interval until no
+// check is running, logging progress changes. Failed queries are retried,
+// and only parityNotifyFailures failures in a row are an error. final is
+// nil if ctx was canceled first.
+func waitForParityCheck(ctx context.Context, interval time.Duration) (final *client.ParityCheck, sawRunning bool, err error) {
+	table := outputFormat == "" || outputFormat == "table"
+	failures := 0
+	lastProgress := -1
+
+	for {
+		reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		status, err := apiClient.GetParityCheckStatus(reqCtx)
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return nil, sawRunning, nil
+		case err != nil:
+			failures++
+			if failures >= parityNotifyFailures {
+				return nil, sawRunning, fmt.Errorf("failed to get parity check status %d times in a row: %w", failures, err)
+			}
+			if table {
+				logf("%s", output.Warning(fmt.Sprintf("Failed to get parity check status (%d/%d): %v", failures, parityNotifyFailures, err)))
 			}
+		case !status.Running:
+			return status, sawRunning, nil
+		default:
+			failures = 0
+			sawRunning = true
+			if table && status.Progress != lastProgress {
+				state := "running"
+				if status.Paused {
+					state = "paused"
+				}
+				logf("Parity check %s: %d%%", state, status.Progress)
+				lastProgress = status.Progress
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, sawRunning, nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// parityErrorsExitCode is the exit status of parity status
+// --notify-on-complete when the check found errors, distinct from the
+// generic failure status 1
+const parityErrorsExitCode = 2
+
+// printParityStatus prints a parity check status, including a progress bar
+// and, when enough samples have been tracked, the measured speed and ETA
+func printParityStatus(status *client.ParityCheck, tracker *progressTracker, paritySize int64) {
+	fmt.Printf("Status: %s\n", status.Status)
 
-			if status.Errors > 0 {
-				fmt.Printf("Errors: %d\n", status.Errors)
-			} else if status.Date != "" {
-				fmt.Printf("Errors: 0\n")
+	if status.Running {
+		fmt.Printf("Running: ✓\n")
+		fmt.Printf("Progress: %s %d%%\n", output.Colorize(output.ProgressBar(float64(status.Progress), 30), output.ColorCyan), status.Progress)
+	} else {
+		fmt.Printf("Running: ✗\n")
+	}
+
+	if status.Paused {
+		fmt.Printf("Paused: ✓\n")
+	}
+
+	if status.Correcting {
+		fmt.Printf("Correcting: ✓\n")
+	}
+
+	if status.Date != "" {
+		fmt.Printf("Last Check: %s\n", status.Date)
+	}
+
+	if status.Duration > 0 {
+		duration := time.Duration(status.Duration) * time.Second
+		fmt.Printf("Duration: %s\n", duration.String())
+	}
+
+	if status.Speed != "" {
+		fmt.Printf("Speed: %s\n", status.Speed)
+	}
+
+	if status.Running && !status.Paused && tracker != nil && len(tracker.samples) > 1 {
+		if rate, ok := tracker.Rate(); ok {
+			if paritySize > 0 {
+				bytesPerSecond := rate / 100 * float64(paritySize)
+				fmt.Printf("Measured Speed: %s/s\n", output.FormatBytes(int64(bytesPerSecond)))
 			}
+
+			remaining := time.Duration((100 - tracker.Latest()) / rate * float64(time.Second)).Round(time.Minute)
+			fmt.Printf("ETA: %s (around %s)\n", remaining, time.Now().Add(remaining).Format("2006-01-02 15:04"))
 		} else {
-			formatter.Print(status)
+			fmt.Printf("ETA: %s\n", output.Gray("measuring..."))
 		}
+	}
 
-		return nil
-	},
+	if status.Errors > 0 {
+		fmt.Printf("Errors: %d\n", status.Errors)
+	} else if status.Date != "" || status.Running {
+		fmt.Printf("Errors: 0\n")
+	}
+}
+
+// progressSample is a parity check progress reading at a point in time
+type progressSample struct {
+	at       time.Time
+	progress float64
+}
+
+// progressTracker keeps recent progress samples to estimate the rate of progress
+type progressTracker struct {
+	samples []progressSample
+}
+
+// maxProgressSamples bounds the sliding window used for rate estimates
+const maxProgressSamples = 60
+
+// Add records a progress reading, starting over if progress went backwards
+// (i.e. a new check was started)
+func (t *progressTracker) Add(progress int) {
+	if len(t.samples) > 0 && float64(progress) < t.Latest() {
+		t.samples = nil
+	}
+
+	t.samples = append(t.samples, progressSample{at: time.Now(), progress: float64(progress)})
+	if len(t.samples) > maxProgressSamples {
+		t.samples = t.samples[len(t.samples)-maxProgressSamples:]
+	}
+}
+
+// Latest returns the most recent progress reading
+func (t *progressTracker) Latest() float64 {
+	if len(t.samples) == 0 {
+		return 0
+	}
+	return t.samples[len(t.samples)-1].progress
+}
+
+// Rate returns the progress rate in percent per second over the window.
+// ok is false until progress has advanced at least once.
+func (t *progressTracker) Rate() (float64, bool) {
+	if len(t.samples) < 2 {
+		return 0, false
+	}
+
+	first, last := t.samples[0], t.samples[len(t.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	delta := last.progress - first.progress
+	if elapsed <= 0 || delta <= 0 {
+		return 0, false
+	}
+
+	return delta / elapsed, true
 }
 
 // parityHistoryCmd represents the parity history command
@@ -122,6 +377,225 @@ var parityHistoryCmd = &cobra.Command{
 	},
 }
 
+// parityAnomaly is a parity check that found errors or ran unusually slowly
+type parityAnomaly struct {
+	Date        string  `json:"date" yaml:"date"`
+	Speed       float64 `json:"speedBytesPerSecond" yaml:"speedBytesPerSecond"`
+	MedianSpeed float64 `json:"rollingMedianBytesPerSecond" yaml:"rollingMedianBytesPerSecond"`
+	Duration    int     `json:"durationSeconds" yaml:"durationSeconds"`
+	Errors      int     `json:"errors" yaml:"errors"`
+	Reason      string  `json:"reason" yaml:"reason"`
+}
+
+// parityStatistics summarizes the parity check history
+type parityStatistics struct {
+	Checks                int             `json:"checks" yaml:"checks"`
+	First                 string          `json:"first" yaml:"first"`
+	Last                  string          `json:"last" yaml:"last"`
+	AverageSpeed          float64         `json:"averageSpeedBytesPerSecond" yaml:"averageSpeedBytesPerSecond"`
+	MinSpeed              float64         `json:"minSpeedBytesPerSecond" yaml:"minSpeedBytesPerSecond"`
+	MaxSpeed              float64         `json:"maxSpeedBytesPerSecond" yaml:"maxSpeedBytesPerSecond"`
+	AverageDuration       float64         `json:"averageDurationSeconds" yaml:"averageDurationSeconds"`
+	DurationTrendPerMonth float64         `json:"durationTrendSecondsPerMonth" yaml:"durationTrendSecondsPerMonth"`
+	TotalErrors           int             `json:"totalErrors" yaml:"totalErrors"`
+	Anomalies             []parityAnomaly `json:"anomalies" yaml:"anomalies"`
+	UnparseableChecks     int             `json:"unparseableChecks" yaml:"unparseableChecks"`
+}
+
+// parityStatsCmd represents the parity stats command
+var parityStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Analyze parity check history",
+	Long: `Analyze the parity check history: average, minimum, and maximum speed, the
+trend in check duration over time, and checks that found errors or ran
+sharply slower than the rolling median of the preceding checks. A sudden
+drop in parity check speed is often an early sign of a failing disk.
+
+Examples:
+  unraidcli parity stats
+  unraidcli parity stats --drop 20 --window 3
+  unraidcli parity stats -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if parityStatsWindow < 1 {
+			return fmt.Errorf("--window must be at least 1")
+		}
+		if parityStatsDrop < 0 || parityStatsDrop > 100 {
+			return fmt.Errorf("--drop must be between 0 and 100")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		history, err := apiClient.GetParityHistory(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get parity history: %w", err)
+		}
+
+		result := analyzeParityHistory(history, parityStatsWindow, parityStatsDrop)
+
+		if result.Checks == 0 {
+			fmt.Println("No parity check history with a usable speed found.")
+			return nil
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("Checks analyzed: %d (%s to %s)\n", result.Checks, result.First, result.Last)
+			if result.UnparseableChecks > 0 {
+				fmt.Printf("Skipped: %d check(s) with an unrecognized date or speed\n", result.UnparseableChecks)
+			}
+			fmt.Printf("Average Speed: %s/s\n", output.FormatBytes(int64(result.AverageSpeed)))
+			fmt.Printf("Min Speed: %s/s\n", output.FormatBytes(int64(result.MinSpeed)))
+			fmt.Printf("Max Speed: %s/s\n", output.FormatBytes(int64(result.MaxSpeed)))
+			fmt.Printf("Average Duration: %s\n", (time.Duration(result.AverageDuration) * time.Second).Round(time.Minute))
+
+			trend := (time.Duration(result.DurationTrendPerMonth) * time.Second).Round(time.Minute)
+			switch {
+			case trend > 0:
+				fmt.Printf("Duration Trend: %s\n", output.Yellow(fmt.Sprintf("+%s per month (getting slower)", trend)))
+			case trend < 0:
+				fmt.Printf("Duration Trend: %s\n", output.Green(fmt.Sprintf("-%s per month (getting faster)", -trend)))
+			default:
+				fmt.Printf("Duration Trend: %s\n", output.Green("stable"))
+			}
+
+			if result.TotalErrors > 0 {
+				fmt.Printf("Total Errors: %s\n", output.Red(fmt.Sprintf("%d", result.TotalErrors)))
+			} else {
+				fmt.Printf("Total Errors: 0\n")
+			}
+
+			if len(result.Anomalies) == 0 {
+				fmt.Printf("\n%s\n", output.Success("No anomalies found"))
+				return nil
+			}
+
+			fmt.Printf("\nAnomalies:\n")
+			headers := []string{"Date", "Duration", "Speed", "Rolling Median", "Errors", "Reason"}
+			var rows [][]string
+
+			for _, anomaly := range result.Anomalies {
+				median := "-"
+				if anomaly.MedianSpeed > 0 {
+					median = output.FormatBytes(int64(anomaly.MedianSpeed)) + "/s"
+				}
+
+				rows = append(rows, []string{
+					anomaly.Date,
+					(time.Duration(anomaly.Duration) * time.Second).String(),
+					output.FormatBytes(int64(anomaly.Speed)) + "/s",
+					median,
+					fmt.Sprintf("%d", anomaly.Errors),
+					output.Red(anomaly.Reason),
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+		} else {
+			formatter.Print(result)
+		}
+
+		return nil
+	},
+}
+
+// analyzeParityHistory computes speed and duration statistics and flags
+// checks with errors or with a speed more than dropPercent below the median
+// of the preceding window checks
+func analyzeParityHistory(history []client.ParityCheck, window int, dropPercent float64) parityStatistics {
+	type check struct {
+		client.ParityCheck
+		at    time.Time
+		speed float64
+	}
+
+	result := parityStatistics{Anomalies: []parityAnomaly{}}
+
+	var checks []check
+	for _, entry := range history {
+		at, err := entry.Time()
+		if err != nil {
+			result.UnparseableChecks++
+			continue
+		}
+		speed, err := entry.SpeedBytesPerSecond()
+		if err != nil || speed <= 0 {
+			result.UnparseableChecks++
+			continue
+		}
+		checks = append(checks, check{ParityCheck: entry, at: at, speed: speed})
+	}
+
+	if len(checks) == 0 {
+		return result
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].at.Before(checks[j].at)
+	})
+
+	result.Checks = len(checks)
+	result.First = checks[0].at.Format("2006-01-02")
+	result.Last = checks[len(checks)-1].at.Format("2006-01-02")
+	result.MinSpeed = checks[0].speed
+
+	var totalSpeed, totalDuration float64
+	var days, durations []float64
+
+	for i, c := range checks {
+		totalSpeed += c.speed
+		totalDuration += float64(c.Duration)
+		result.TotalErrors += c.Errors
+
+		if c.speed < result.MinSpeed {
+			result.MinSpeed = c.speed
+		}
+		if c.speed > result.MaxSpeed {
+			result.MaxSpeed = c.speed
+		}
+
+		days = append(days, c.at.Sub(checks[0].at).Hours()/24)
+		durations = append(durations, float64(c.Duration))
+
+		// Compare against the median of the preceding checks
+		var median float64
+		if i > 0 {
+			var previous []float64
+			for _, p := range checks[max(0, i-window):i] {
+				previous = append(previous, p.speed)
+			}
+			median = stats.Median(previous)
+		}
+
+		var reasons []string
+		if c.Errors > 0 {
+			reasons = append(reasons, fmt.Sprintf("%d error(s) found", c.Errors))
+		}
+		if median > 0 && c.speed < median*(1-dropPercent/100) {
+			reasons = append(reasons, fmt.Sprintf("speed %.0f%% below rolling median", (1-c.speed/median)*100))
+		}
+
+		if len(reasons) > 0 {
+			result.Anomalies = append(result.Anomalies, parityAnomaly{
+				Date:        c.Date,
+				Speed:       c.speed,
+				MedianSpeed: median,
+				Duration:    c.Duration,
+				Errors:      c.Errors,
+				Reason:      strings.Join(reasons, "; "),
+			})
+		}
+	}
+
+	result.AverageSpeed = totalSpeed / float64(len(checks))
+	result.AverageDuration = totalDuration / float64(len(checks))
+
+	if slope, _, ok := stats.LinearFit(days, durations); ok {
+		result.DurationTrendPerMonth = slope * 30
+	}
+
+	return result
+}
+
 // parityStartCmd represents the parity start command
 var parityStartCmd = &cobra.Command{
 	Use:   "start",
@@ -206,15 +680,376 @@ var parityCancelCmd = &cobra.Command{
 	},
 }
 
+// parityScheduleCmd represents the parity schedule command
+var parityScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage the parity check schedule",
+	Long:  "Show or change when automatic parity checks run.",
+}
+
+// parityScheduleShowCmd represents the parity schedule show command
+var parityScheduleShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the parity check schedule",
+	Long:  "Display the schedule for automatic parity checks.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		schedule, err := apiClient.GetParityCheckSchedule(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get parity check schedule: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			printParitySchedule(schedule)
+		} else {
+			formatter.Print(schedule)
+		}
+
+		return nil
+	},
+}
+
+// parityScheduleSetCmd represents the parity schedule set command
+var parityScheduleSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change the parity check schedule",
+	Long: `Change the schedule for automatic parity checks. Only the settings given
+as flags are changed; everything else keeps its current value.
+
+Use --correct=false for read-only (non-correcting) checks. Cumulative checks
+run in increments of --duration hours, continuing every --frequency days
+until the check is complete.
+
+Examples:
+  unraidcli parity schedule set --mode monthly --day-of-month 1 --time 02:00
+  unraidcli parity schedule set --mode weekly --day-of-week sun --correct=false
+  unraidcli parity schedule set --cumulative --frequency 1 --duration 6
+  unraidcli parity schedule set --mode disabled`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		changed := false
+		cmd.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+			changed = changed || f.Changed
+		})
+		if !changed {
+			return fmt.Errorf("no schedule changes given (see --help)")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		schedule, err := apiClient.GetParityCheckSchedule(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get parity check schedule: %w", err)
+		}
+
+		flags := cmd.Flags()
+		if flags.Changed("mode") {
+			mode := strings.ToUpper(scheduleMode)
+			switch mode {
+			case "DISABLED", "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				schedule.Mode = mode
+			default:
+				return fmt.Errorf("invalid mode %q (use disabled, daily, weekly, monthly or yearly)", scheduleMode)
+			}
+		}
+		if flags.Changed("day-of-week") {
+			day, err := parseWeekday(scheduleDayOfWeek)
+			if err != nil {
+				return err
+			}
+			schedule.DayOfWeek = int(day)
+		}
+		if flags.Changed("day-of-month") {
+			if scheduleDayOfMonth < 1 || scheduleDayOfMonth > 31 {
+				return fmt.Errorf("invalid day of month %d (use 1-31)", scheduleDayOfMonth)
+			}
+			schedule.DayOfMonth = scheduleDayOfMonth
+		}
+		if flags.Changed("month") {
+			month, err := parseMonth(scheduleMonth)
+			if err != nil {
+				return err
+			}
+			schedule.Month = int(month)
+		}
+		if flags.Changed("time") {
+			minutes, err := parseClock(scheduleTime)
+			if err != nil {
+				return err
+			}
+			schedule.Hour, schedule.Minute = minutes/60, minutes%60
+		}
+		if flags.Changed("correct") {
+			schedule.Correcting = scheduleCorrect
+		}
+		if flags.Changed("cumulative") {
+			schedule.Cumulative = scheduleCumulative
+		}
+		if flags.Changed("frequency") {
+			if scheduleFrequency < 1 {
+				return fmt.Errorf("frequency must be at least 1 day")
+			}
+			schedule.Frequency = scheduleFrequency
+		}
+		if flags.Changed("duration") {
+			if scheduleDuration < 1 {
+				return fmt.Errorf("duration must be at least 1 hour")
+			}
+			schedule.Duration = scheduleDuration
+		}
+
+		updated, err := apiClient.SetParityCheckSchedule(ctx, *schedule)
+		if err != nil {
+			return fmt.Errorf("failed to set parity check schedule: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Println("✓ Parity check schedule updated")
+			printParitySchedule(updated)
+		} else {
+			formatter.Print(updated)
+		}
+
+		return nil
+	},
+}
+
+// printParitySchedule prints a parity check schedule
+func printParitySchedule(schedule *client.ParityCheckSchedule) {
+	fmt.Printf("Schedule: %s\n", describeParitySchedule(schedule))
+	if schedule.Mode == "DISABLED" {
+		return
+	}
+
+	if schedule.Correcting {
+		fmt.Printf("Mode: correcting\n")
+	} else {
+		fmt.Printf("Mode: read-only\n")
+	}
+
+	if schedule.Cumulative {
+		fmt.Printf("Cumulative: ✓ (%dh increments every %d day(s))\n", schedule.Duration, schedule.Frequency)
+	} else {
+		fmt.Printf("Cumulative: ✗\n")
+	}
+}
+
+// describeParitySchedule returns a schedule as a sentence, e.g.
+// "Weekly on Sunday at 03:00"
+func describeParitySchedule(schedule *client.ParityCheckSchedule) string {
+	at := fmt.Sprintf("%02d:%02d", schedule.Hour, schedule.Minute)
+
+	switch schedule.Mode {
+	case "DAILY":
+		return "Daily at " + at
+	case "WEEKLY":
+		return fmt.Sprintf("Weekly on %s at %s", time.Weekday(schedule.DayOfWeek), at)
+	case "MONTHLY":
+		return fmt.Sprintf("Monthly on day %d at %s", schedule.DayOfMonth, at)
+	case "YEARLY":
+		return fmt.Sprintf("Yearly on %s %d at %s", time.Month(schedule.Month), schedule.DayOfMonth, at)
+	default:
+		return "Disabled"
+	}
+}
+
+// parseWeekday parses a weekday name ("sun", "Sunday") or number (0 = Sunday)
+func parseWeekday(value string) (time.Weekday, error) {
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n <= 6 {
+		return time.Weekday(n), nil
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if v := strings.ToLower(value); v == name || v == name[:3] {
+			return day, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid day of week %q", value)
+}
+
+// parseMonth parses a month name ("jan", "January") or number (1-12)
+func parseMonth(value string) (time.Month, error) {
+	if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+		return time.Month(n), nil
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		name := strings.ToLower(month.String())
+		if v := strings.ToLower(value); v == name || v == name[:3] {
+			return month, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid month %q", value)
+}
+
+// parseClock parses a time of day in HH:MM form into minutes after midnight
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (use HH:MM)", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// parityWindowCmd represents the parity window command
+var parityWindowCmd = &cobra.Command{
+	Use:   "window",
+	Short: "Pause parity checks during working hours",
+	Long: `Run in the foreground and keep parity checks out of a daily maintenance
+window: a running check is paused at --pause-at and resumed at --resume-at.
+The window may span midnight.
+
+The parity check status is checked every --interval seconds. Only checks
+that this command paused are resumed when the window ends; a check paused by
+someone else (e.g. by hand), inside or outside the window, is left alone.
+Stop with Ctrl+C.
+
+Examples:
+  unraidcli parity window --pause-at 07:00 --resume-at 23:00
+  unraidcli parity window --pause-at 18:00 --resume-at 01:30 --interval 300`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if windowPauseAt == "" || windowResumeAt == "" {
+			return fmt.Errorf("both --pause-at and --resume-at are required")
+		}
+
+		pauseAt, err := parseClock(windowPauseAt)
+		if err != nil {
+			return err
+		}
+		resumeAt, err := parseClock(windowResumeAt)
+		if err != nil {
+			return err
+		}
+		if pauseAt == resumeAt {
+			return fmt.Errorf("--pause-at and --resume-at must differ")
+		}
+		if windowInterval < 1 {
+			return fmt.Errorf("--interval must be at least 1 second")
+		}
+
+		// Setup signal handling for graceful exit
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigChan
+			cancel()
+		}()
+
+		logf("Pausing parity checks between %s and %s (Ctrl+C to stop)", windowPauseAt, windowResumeAt)
+
+		// pausedByWindow records that the check was paused by this process,
+		// so only those checks are resumed when the window ends
+		pausedByWindow := false
+
+		check := func() {
+			reqCtx, reqCancel := context.WithTimeout(ctx, 30*time.Second)
+			defer reqCancel()
+
+			status, err := apiClient.GetParityCheckStatus(reqCtx)
+			if err != nil {
+				logf("%s", output.Error(fmt.Sprintf("Failed to get parity check status: %v", err)))
+				return
+			}
+
+			if !status.Running {
+				pausedByWindow = false
+				return
+			}
+
+			inWindow := inPauseWindow(time.Now(), pauseAt, resumeAt)
+
+			switch {
+			case inWindow && !status.Paused:
+				if err := apiClient.PauseParityCheck(reqCtx); err != nil {
+					logf("%s", output.Error(fmt.Sprintf("Failed to pause parity check: %v", err)))
+					return
+				}
+				pausedByWindow = true
+				logf("✓ Paused parity check at %d%%", status.Progress)
+			case !inWindow && !status.Paused:
+				// Resumed by someone else; nothing left for us to resume
+				pausedByWindow = false
+			case !inWindow && status.Paused && pausedByWindow:
+				if err := apiClient.ResumeParityCheck(reqCtx); err != nil {
+					logf("%s", output.Error(fmt.Sprintf("Failed to resume parity check: %v", err)))
+					return
+				}
+				pausedByWindow = false
+				logf("✓ Resumed parity check at %d%%", status.Progress)
+			}
+		}
+
+		check()
+
+		ticker := time.NewTicker(time.Duration(windowInterval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				logf("Stopped")
+				return nil
+			case <-ticker.C:
+				check()
+			}
+		}
+	},
+}
+
+// logf prints a timestamped line, for long-running commands
+func logf(format string, a ...interface{}) {
+	fmt.Printf("[%s] %s\n", time.Now().Format("2006-01-02 15:04:05"), fmt.Sprintf(format, a...))
+}
+
+// inPauseWindow reports whether t falls between pauseAt (inclusive) and
+// resumeAt (exclusive), both in minutes after midnight, wrapping at midnight
+func inPauseWindow(t time.Time, pauseAt int, resumeAt int) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	if pauseAt < resumeAt {
+		return minutes >= pauseAt && minutes < resumeAt
+	}
+	return minutes >= pauseAt || minutes < resumeAt
+}
+
 func init() {
 	rootCmd.AddCommand(parityCmd)
 	parityCmd.AddCommand(parityStatusCmd)
 	parityCmd.AddCommand(parityHistoryCmd)
+	parityCmd.AddCommand(parityStatsCmd)
 	parityCmd.AddCommand(parityStartCmd)
 	parityCmd.AddCommand(parityPauseCmd)
 	parityCmd.AddCommand(parityResumeCmd)
 	parityCmd.AddCommand(parityCancelCmd)
+	parityCmd.AddCommand(parityScheduleCmd)
+	parityCmd.AddCommand(parityWindowCmd)
+	parityScheduleCmd.AddCommand(parityScheduleShowCmd)
+	parityScheduleCmd.AddCommand(parityScheduleSetCmd)
 
 	// Add flags
+	parityStatusCmd.Flags().BoolVarP(&parityWatch, "watch", "w", false, "Watch mode - auto-refresh every N seconds")
+	parityStatusCmd.Flags().IntVarP(&parityInterval, "interval", "i", 5, "Refresh interval in seconds for watch mode")
+	parityStatusCmd.Flags().BoolVar(&parityNotify, "notify-on-complete", false, "Wait for the running check to finish and exit with its error count")
+	parityStatsCmd.Flags().IntVar(&parityStatsWindow, "window", 5, "Number of preceding checks in the rolling median")
+	parityStatsCmd.Flags().Float64Var(&parityStatsDrop, "drop", 25, "Flag checks this many percent slower than the rolling median")
+	parityScheduleSetCmd.Flags().StringVar(&scheduleMode, "mode", "", "Schedule mode: disabled, daily, weekly, monthly, yearly")
+	parityScheduleSetCmd.Flags().StringVar(&scheduleDayOfWeek, "day-of-week", "", "Day of week for weekly checks (e.g. sun, monday, 0-6)")
+	parityScheduleSetCmd.Flags().IntVar(&scheduleDayOfMonth, "day-of-month", 0, "Day of month for monthly and yearly checks (1-31)")
+	parityScheduleSetCmd.Flags().StringVar(&scheduleMonth, "month", "", "Month for yearly checks (e.g. jan, 1-12)")
+	parityScheduleSetCmd.Flags().StringVar(&scheduleTime, "time", "", "Time of day to start (HH:MM)")
+	parityScheduleSetCmd.Flags().BoolVar(&scheduleCorrect, "correct", false, "Correct parity errors (--correct=false for read-only checks)")
+	parityScheduleSetCmd.Flags().BoolVar(&scheduleCumulative, "cumulative", false, "Run the check in increments")
+	parityScheduleSetCmd.Flags().IntVar(&scheduleFrequency, "frequency", 0, "Days between cumulative increments")
+	parityScheduleSetCmd.Flags().IntVar(&scheduleDuration, "duration", 0, "Hours per cumulative increment")
+	parityWindowCmd.Flags().StringVar(&windowPauseAt, "pause-at", "", "Time of day to pause a running check (HH:MM)")
+	parityWindowCmd.Flags().StringVar(&windowResumeAt, "resume-at", "", "Time of day to resume the check (HH:MM)")
+	parityWindowCmd.Flags().IntVarP(&windowInterval, "interval", "i", 60, "Seconds between status checks")
 	parityStartCmd.Flags().BoolVarP(&correctingErrors, "correct", "c", false, "Enable error correction (write mode)")
 }
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
 	"github.com/spf13/cobra"
 )
 
 var (
-	pluginBundled bool
-	pluginRestart bool
+	pluginBundled     bool
+	pluginRestart     bool
+	pluginUpdatesOnly bool
+	pluginUpdateAll   bool
 )
 
 // pluginCmd represents the plugin command
@@ -18,7 +22,7 @@ var pluginCmd = &cobra.Command{
 	Use:     "plugin",
 	Aliases: []string{"plugins"},
 	Short:   "Manage plugins",
-	Long:    "List, add, and remove plugins on your Unraid server.",
+	Long:    "List, add, update, and remove plugins on your Unraid server.",
 }
 
 // pluginLsCmd represents the plugin ls command
@@ -36,14 +40,34 @@ var pluginLsCmd = &cobra.Command{
 			return fmt.Errorf("failed to get plugins: %w", err)
 		}
 
+		if pluginUpdatesOnly {
+			outdated := []client.Plugin{}
+			for _, plugin := range plugins {
+				if plugin.UpdateAvailable() {
+					outdated = append(outdated, plugin)
+				}
+			}
+			plugins = outdated
+
+			if len(plugins) == 0 {
+				if outputFormat == "" || outputFormat == "table" {
+					fmt.Println("✓ All plugins are up to date.")
+				} else {
+					formatter.Print(plugins)
+				}
+				return nil
+			}
+		}
+
 		if len(plugins) == 0 {
 			fmt.Println("No plugins found.")
 			return nil
 		}
 
 		if outputFormat == "" || outputFormat == "table" {
-			headers := []string{"Name", "Version", "API Module", "CLI Module"}
+			headers := []string{"Name", "Version", "Latest", "API Module", "CLI Module"}
 			var rows [][]string
+			updates := 0
 
 			for _, plugin := range plugins {
 				apiModule := "No"
@@ -56,9 +80,16 @@ var pluginLsCmd = &cobra.Command{
 					cliModule = "Yes"
 				}
 
+				latest := plugin.LatestVersion
+				if plugin.UpdateAvailable() {
+					latest = output.Yellow(latest + " ↑")
+					updates++
+				}
+
 				rows = append(rows, []string{
 					plugin.Name,
 					plugin.Version,
+					latest,
 					apiModule,
 					cliModule,
 				})
@@ -66,6 +97,9 @@ var pluginLsCmd = &cobra.Command{
 
 			formatter.PrintTable(headers, rows)
 			fmt.Printf("\nTotal: %d plugin(s)\n", len(plugins))
+			if updates > 0 {
+				fmt.Printf("%s\n", output.Warning(fmt.Sprintf("%d update(s) available; run 'unraidcli plugin update --all' to install", updates)))
+			}
 		} else {
 			formatter.Print(plugins)
 		}
@@ -141,11 +175,147 @@ var pluginRemoveCmd = &cobra.Command{
 	},
 }
 
+// pluginUpdateCmd represents the plugin update command
+var pluginUpdateCmd = &cobra.Command{
+	Use:     "update [plugin...]",
+	Aliases: []string{"upgrade"},
+	Short:   "Update plugins to their latest versions",
+	Long: `Update the named plugins, or all plugins with available updates (--all).
+Plugins are updated one at a time, and the API is restarted once at the end
+rather than after each plugin.
+
+Examples:
+  unraidcli plugin ls --updates
+  unraidcli plugin update unraid-api-plugin-connect
+  unraidcli plugin update --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 && pluginUpdateAll {
+			return fmt.Errorf("specify plugin names or --all, not both")
+		}
+		if len(args) == 0 && !pluginUpdateAll {
+			return fmt.Errorf("specify plugin names or --all")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		plugins, err := apiClient.GetPlugins(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get plugins: %w", err)
+		}
+
+		byName := make(map[string]client.Plugin)
+		for _, plugin := range plugins {
+			byName[plugin.Name] = plugin
+		}
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		var toUpdate []client.Plugin
+		if pluginUpdateAll {
+			for _, plugin := range plugins {
+				if plugin.UpdateAvailable() {
+					toUpdate = append(toUpdate, plugin)
+				}
+			}
+		} else {
+			for _, name := range args {
+				plugin, ok := byName[name]
+				if !ok {
+					return fmt.Errorf("plugin '%s' is not installed", name)
+				}
+				if !plugin.UpdateAvailable() {
+					if table {
+						fmt.Printf("Plugin '%s' is up to date (%s)\n", name, plugin.Version)
+					}
+					continue
+				}
+				toUpdate = append(toUpdate, plugin)
+			}
+		}
+
+		if len(toUpdate) == 0 {
+			if table {
+				fmt.Println("✓ All plugins are up to date.")
+			} else {
+				formatter.Print(map[string]interface{}{
+					"status":  "success",
+					"message": "All plugins are up to date",
+					"plugins": []string{},
+				})
+			}
+			return nil
+		}
+
+		updated := []string{}
+		var failed []string
+		for i, plugin := range toUpdate {
+			if table {
+				fmt.Printf("  [%d/%d] Updating '%s' (%s → %s)... ", i+1, len(toUpdate), plugin.Name, plugin.Version, plugin.LatestVersion)
+			}
+
+			updateCtx, updateCancel := context.WithTimeout(context.Background(), 120*time.Second)
+			err := apiClient.UpdatePlugin(updateCtx, []string{plugin.Name}, false)
+			updateCancel()
+
+			if err != nil {
+				if table {
+					fmt.Printf("✗ Failed: %v\n", err)
+				}
+				failed = append(failed, plugin.Name)
+			} else {
+				if table {
+					fmt.Printf("✓\n")
+				}
+				updated = append(updated, plugin.Name)
+			}
+		}
+
+		if len(updated) > 0 && pluginRestart {
+			if table {
+				fmt.Print("  Restarting API... ")
+			}
+
+			restartCtx, restartCancel := context.WithTimeout(context.Background(), 60*time.Second)
+			err := apiClient.RestartAPI(restartCtx)
+			restartCancel()
+
+			if err != nil {
+				if table {
+					fmt.Printf("✗ Failed: %v\n", err)
+				}
+				return fmt.Errorf("plugins updated but the API restart failed: %w", err)
+			}
+			if table {
+				fmt.Printf("✓\n")
+			}
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to update %d plugin(s): %v", len(failed), failed)
+		}
+
+		if table {
+			fmt.Printf("✓ Updated %d plugin(s)\n", len(updated))
+		} else {
+			formatter.Print(map[string]interface{}{
+				"status":  "success",
+				"message": "Plugins updated successfully",
+				"plugins": updated,
+			})
+		}
+		return nil
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(pluginCmd)
 	pluginCmd.AddCommand(pluginLsCmd)
 	pluginCmd.AddCommand(pluginAddCmd)
 	pluginCmd.AddCommand(pluginRemoveCmd)
+	pluginCmd.AddCommand(pluginUpdateCmd)
+
+	pluginLsCmd.Flags().BoolVar(&pluginUpdatesOnly, "updates", false, "Only list plugins with updates available")
 
 	// Add flags for add and remove commands
 	pluginAddCmd.Flags().BoolVar(&pluginBundled, "bundled", false, "Treat plugins as bundled plugins")
@@ -153,4 +323,7 @@ func init() {
 
 	pluginRemoveCmd.Flags().BoolVar(&pluginBundled, "bundled", false, "Treat plugins as bundled plugins")
 	pluginRemoveCmd.Flags().BoolVar(&pluginRestart, "restart", true, "Restart the API after the operation")
+
+	pluginUpdateCmd.Flags().BoolVar(&pluginUpdateAll, "all", false, "Update all plugins with available updates")
+	pluginUpdateCmd.Flags().BoolVar(&pluginRestart, "restart", true, "Restart the API once after all updates")
 }
@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	syncFile       string
+	syncDryRun     bool
+	syncPrune      bool
+	syncAllServers bool
+)
+
+// pluginManifest is the desired set of plugins read by plugin sync
+type pluginManifest struct {
+	Plugins []manifestPlugin `yaml:"plugins"`
+}
+
+// manifestPlugin is a desired plugin, optionally pinned to a version
+type manifestPlugin struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version,omitempty"`
+}
+
+// UnmarshalYAML accepts either a plain plugin name or a name/version mapping
+func (p *manifestPlugin) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		p.Name = node.Value
+		return nil
+	}
+
+	type plain manifestPlugin
+	return node.Decode((*plain)(p))
+}
+
+// syncAction is one step of a plugin sync plan
+type syncAction struct {
+	Server  string `json:"server" yaml:"server"`
+	Action  string `json:"action" yaml:"action"`
+	Plugin  string `json:"plugin" yaml:"plugin"`
+	Current string `json:"current,omitempty" yaml:"current,omitempty"`
+	Desired string `json:"desired,omitempty" yaml:"desired,omitempty"`
+}
+
+// pluginSyncCmd represents the plugin sync command
+var pluginSyncCmd = &cobra.Command{
+	Use:   "sync -f <manifest>",
+	Short: "Converge installed plugins to a manifest",
+	Long: `Install the plugins listed in a YAML manifest that are missing. With
+--prune, plugins not in the manifest are removed. The API is restarted once
+per server after any change.
+
+The API cannot install a specific plugin version, so missing plugins are
+installed at the version the API provides. An installed plugin whose version
+differs from its pinned version is reported as a mismatch and fails the sync;
+update or reinstall it manually.
+
+With --all-servers, every configured server is synced in turn. Use --dry-run
+to print the plan without changing anything.
+
+Manifest format:
+  plugins:
+    - unraid-api-plugin-connect        # any version
+    - name: unraid-api-plugin-example
+      version: "1.2.3"                 # pinned
+
+Examples:
+  unraidcli plugin sync -f plugins.yaml --dry-run
+  unraidcli plugin sync -f plugins.yaml
+  unraidcli plugin sync -f plugins.yaml --prune --all-servers`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if syncFile == "" {
+			return fmt.Errorf("a manifest is required (-f plugins.yaml)")
+		}
+
+		manifest, err := loadPluginManifest(syncFile)
+		if err != nil {
+			return err
+		}
+
+		// Target the current server, or every configured server
+		current, err := currentServerName()
+		if err != nil {
+			return err
+		}
+
+		servers := map[string]*client.Client{current: apiClient}
+		if syncAllServers {
+			servers = make(map[string]*client.Client)
+			for name, server := range cfg.Servers {
+				servers[name] = client.New(server.URL, server.APIKey)
+			}
+		}
+
+		var names []string
+		for name := range servers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var plan []syncAction
+		var failed []string
+
+		for i, name := range names {
+			if (outputFormat == "" || outputFormat == "table") && len(names) > 1 {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("Server: %s\n", name)
+			}
+
+			actions, err := syncServer(name, servers[name], manifest)
+			plan = append(plan, actions...)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, output.Error(fmt.Sprintf("%s: %v", name, err)))
+				failed = append(failed, name)
+			}
+		}
+
+		if outputFormat != "" && outputFormat != "table" {
+			if plan == nil {
+				plan = []syncAction{}
+			}
+			formatter.Print(plan)
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to sync %d server(s): %v", len(failed), failed)
+		}
+
+		return nil
+	},
+}
+
+// loadPluginManifest reads and validates a plugin manifest
+func loadPluginManifest(path string) (*pluginManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest pluginManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, plugin := range manifest.Plugins {
+		if plugin.Name == "" {
+			return nil, fmt.Errorf("manifest contains a plugin without a name")
+		}
+		if seen[plugin.Name] {
+			return nil, fmt.Errorf("plugin '%s' is listed more than once", plugin.Name)
+		}
+		seen[plugin.Name] = true
+	}
+
+	return &manifest, nil
+}
+
+// planPluginSync compares installed plugins with the manifest
+func planPluginSync(server string, installed []client.Plugin, manifest *pluginManifest, prune bool) []syncAction {
+	current := make(map[string]client.Plugin)
+	for _, plugin := range installed {
+		current[plugin.Name] = plugin
+	}
+
+	var actions []syncAction
+	wanted := make(map[string]bool)
+
+	for _, desired := range manifest.Plugins {
+		wanted[desired.Name] = true
+
+		plugin, ok := current[desired.Name]
+		switch {
+		case !ok:
+			actions = append(actions, syncAction{Server: server, Action: "install", Plugin: desired.Name, Desired: desired.Version})
+		case desired.Version != "" && desired.Version != plugin.Version:
+			actions = append(actions, syncAction{Server: server, Action: "mismatch", Plugin: desired.Name, Current: plugin.Version, Desired: desired.Version})
+		}
+	}
+
+	if prune {
+		for _, plugin := range installed {
+			if !wanted[plugin.Name] {
+				actions = append(actions, syncAction{Server: server, Action: "remove", Plugin: plugin.Name, Current: plugin.Version})
+			}
+		}
+	}
+
+	return actions
+}
+
+// syncServer plans and, unless --dry-run is given, applies the sync for one
+// server. It returns the planned actions.
+func syncServer(name string, c *client.Client, manifest *pluginManifest) ([]syncAction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	installed, err := c.GetPlugins(ctx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plugins: %w", err)
+	}
+
+	actions := planPluginSync(name, installed, manifest, syncPrune)
+	table := outputFormat == "" || outputFormat == "table"
+
+	if len(actions) == 0 {
+		if table {
+			fmt.Println("✓ Plugins match the manifest")
+		}
+		return actions, nil
+	}
+
+	if table && syncDryRun {
+		headers := []string{"Action", "Plugin", "Current", "Desired"}
+		var rows [][]string
+
+		for _, action := range actions {
+			verb := output.Green(action.Action)
+			if action.Action == "remove" {
+				verb = output.Red(action.Action)
+			} else if action.Action == "mismatch" {
+				verb = output.Yellow(action.Action)
+			}
+
+			rows = append(rows, []string{verb, action.Plugin, dashIfEmpty(action.Current), dashIfEmpty(action.Desired)})
+		}
+
+		formatter.PrintTable(headers, rows)
+	}
+
+	// Pinned versions cannot be installed through the API, so a mismatch
+	// is reported rather than applied
+	var mismatched []string
+	var changes []syncAction
+	for _, action := range actions {
+		if action.Action == "mismatch" {
+			mismatched = append(mismatched, action.Plugin)
+			if !syncDryRun {
+				fmt.Fprintln(os.Stderr, output.Error(fmt.Sprintf("'%s' is at version %s, pinned to %s", action.Plugin, dashIfEmpty(action.Current), action.Desired)))
+			}
+			continue
+		}
+		changes = append(changes, action)
+	}
+
+	if syncDryRun {
+		if len(mismatched) > 0 {
+			return actions, fmt.Errorf("%d plugin(s) do not match their pinned version: %v", len(mismatched), mismatched)
+		}
+		return actions, nil
+	}
+
+	var failed []string
+	for _, action := range changes {
+		if table {
+			fmt.Printf("  %s '%s'... ", syncVerbs[action.Action], action.Plugin)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+		if action.Action == "remove" {
+			err = c.RemovePlugin(ctx, []string{action.Plugin}, false, false)
+		} else {
+			err = c.AddPlugin(ctx, []string{action.Plugin}, false, false)
+		}
+		cancel()
+
+		if err != nil {
+			if table {
+				fmt.Printf("✗ Failed: %v\n", err)
+			}
+			failed = append(failed, action.Plugin)
+		} else if table {
+			fmt.Printf("✓\n")
+		}
+	}
+
+	if len(failed) < len(changes) {
+		if table {
+			fmt.Print("  Restarting API... ")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		err := c.RestartAPI(ctx)
+		cancel()
+
+		if err != nil {
+			if table {
+				fmt.Printf("✗ Failed: %v\n", err)
+			}
+			return actions, fmt.Errorf("failed to restart API: %w", err)
+		}
+		if table {
+			fmt.Printf("✓\n")
+		}
+	}
+
+	if len(failed) > 0 {
+		return actions, fmt.Errorf("failed to sync %d plugin(s): %v", len(failed), failed)
+	}
+
+	if len(mismatched) > 0 {
+		return actions, fmt.Errorf("%d plugin(s) do not match their pinned version: %v", len(mismatched), mismatched)
+	}
+
+	return actions, nil
+}
+
+// dashIfEmpty returns "-" for an empty string
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// syncVerbs are the progress messages for each sync action
+var syncVerbs = map[string]string{
+	"install": "Installing",
+	"remove":  "Removing",
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginSyncCmd)
+
+	pluginSyncCmd.Flags().StringVarP(&syncFile, "file", "f", "", "Plugin manifest (YAML)")
+	pluginSyncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Print the plan without changing anything")
+	pluginSyncCmd.Flags().BoolVar(&syncPrune, "prune", false, "Remove plugins that are not in the manifest")
+	pluginSyncCmd.Flags().BoolVar(&syncAllServers, "all-servers", false, "Sync every configured server")
+}
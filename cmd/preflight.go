@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+)
+
+// preflightSummary lists the workloads that stopping the array (or shutting
+// down the server) would interrupt
+type preflightSummary struct {
+	Containers []client.Container
+	VMs        []client.VM
+	Parity     *client.ParityCheck
+}
+
+// collectPreflight gathers running containers, running VMs, and any active parity check
+func collectPreflight(ctx context.Context) (*preflightSummary, error) {
+	summary := &preflightSummary{}
+
+	containers, err := apiClient.GetContainers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get containers: %w", err)
+	}
+	for _, container := range containers {
+		if strings.EqualFold(container.State, "running") {
+			summary.Containers = append(summary.Containers, container)
+		}
+	}
+
+	vms, err := apiClient.GetVMs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VMs: %w", err)
+	}
+	for _, vm := range vms {
+		if strings.EqualFold(vm.State, "running") {
+			summary.VMs = append(summary.VMs, vm)
+		}
+	}
+
+	parity, err := apiClient.GetParityCheckStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parity check status: %w", err)
+	}
+	if parity.Running {
+		summary.Parity = parity
+	}
+
+	return summary, nil
+}
+
+// Empty reports whether nothing would be interrupted
+func (p *preflightSummary) Empty() bool {
+	return len(p.Containers) == 0 && len(p.VMs) == 0 && p.Parity == nil
+}
+
+// Print prints the summary
+func (p *preflightSummary) Print() {
+	if p.Empty() {
+		fmt.Println(output.Success("No running containers, VMs, or parity checks"))
+		return
+	}
+
+	if len(p.Containers) > 0 {
+		fmt.Printf("%s\n", output.Warning(fmt.Sprintf("%d running container(s) will be stopped:", len(p.Containers))))
+		for _, container := range p.Containers {
+			fmt.Printf("    %s\n", containerName(container))
+		}
+	}
+
+	if len(p.VMs) > 0 {
+		fmt.Printf("%s\n", output.Warning(fmt.Sprintf("%d running VM(s) will be stopped:", len(p.VMs))))
+		for _, vm := range p.VMs {
+			fmt.Printf("    %s\n", vm.Name)
+		}
+	}
+
+	if p.Parity != nil {
+		state := "running"
+		if p.Parity.Paused {
+			state = "paused"
+		}
+		fmt.Printf("%s\n", output.Warning(fmt.Sprintf("A parity check is %s (%d%% complete) and will be canceled", state, p.Parity.Progress)))
+	}
+}
+
+// StopWorkloads stops running containers and VMs gracefully in reverse
+// autostart order: containers without autostart first, then autostart
+// containers and VMs in reverse of the order they are listed (their start
+// order), so services are stopped after the ones that depend on them. It
+// waits until all VMs have shut down or the context expires.
+func (p *preflightSummary) StopWorkloads(ctx context.Context) error {
+	var ordered []client.Container
+	for _, container := range p.Containers {
+		if !container.Autostart {
+			ordered = append(ordered, container)
+		}
+	}
+	for i := len(p.Containers) - 1; i >= 0; i-- {
+		if p.Containers[i].Autostart {
+			ordered = append(ordered, p.Containers[i])
+		}
+	}
+
+	var failures []string
+
+	for _, container := range ordered {
+		name := containerName(container)
+		fmt.Printf("  Stopping container '%s'... ", name)
+		if err := apiClient.StopContainer(ctx, container.ID); err != nil {
+			fmt.Printf("✗ Failed: %v\n", err)
+			failures = append(failures, name)
+		} else {
+			fmt.Printf("✓\n")
+		}
+	}
+
+	for i := len(p.VMs) - 1; i >= 0; i-- {
+		vm := p.VMs[i]
+		fmt.Printf("  Stopping VM '%s'... ", vm.Name)
+		if err := apiClient.StopVM(ctx, vm.ID); err != nil {
+			fmt.Printf("✗ Failed: %v\n", err)
+			failures = append(failures, vm.Name)
+		} else {
+			fmt.Printf("✓\n")
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to stop %d workload(s): %v", len(failures), failures)
+	}
+
+	if len(p.VMs) == 0 {
+		return nil
+	}
+
+	// VM stop requests an ACPI shutdown, so wait for the guests to power off
+	fmt.Print("  Waiting for VMs to shut down... ")
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		vms, err := apiClient.GetVMs(ctx)
+		if err != nil {
+			fmt.Println("✗")
+			return fmt.Errorf("failed to get VMs: %w", err)
+		}
+
+		running := 0
+		for _, vm := range vms {
+			if strings.EqualFold(vm.State, "running") {
+				running++
+			}
+		}
+		if running == 0 {
+			fmt.Println("✓")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Println("✗")
+			return fmt.Errorf("timed out waiting for %d VM(s) to shut down", running)
+		case <-ticker.C:
+		}
+	}
+}
+
+// confirmTyped asks the user to type word to confirm a destructive action
+func confirmTyped(action string, word string) error {
+	fmt.Printf("\nType '%s' to %s: ", word, action)
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil && answer == "" {
+		return fmt.Errorf("confirmation required (use --yes to skip)")
+	}
+
+	if strings.TrimSpace(answer) != word {
+		return fmt.Errorf("aborted: confirmation did not match")
+	}
+
+	return nil
+}
+
+// containerName returns a container's primary name without the leading slash
+func containerName(container client.Container) string {
+	if len(container.Names) == 0 {
+		return container.ID
+	}
+	return strings.TrimPrefix(container.Names[0], "/")
+}
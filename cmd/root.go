@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/01dnot/unraidcli/internal/client"
 	"github.com/01dnot/unraidcli/internal/config"
@@ -19,6 +20,9 @@ var (
 	formatter    *output.Formatter
 )
 
+// csvAnnotation marks commands that support CSV output
+const csvAnnotation = "csv"
+
 // Version information (set during build)
 var (
 	Version   = "dev"
@@ -52,6 +56,14 @@ information directly from your terminal.`,
 		if format == "" {
 			format = cfg.OutputFormat
 		}
+
+		// CSV is only meaningful for commands that print flat records
+		if strings.EqualFold(format, "csv") && cmd.Annotations[csvAnnotation] == "" {
+			if outputFormat != "" {
+				return fmt.Errorf("CSV output is not supported by '%s'; use json or yaml", cmd.CommandPath())
+			}
+			format = "table"
+		}
 		formatter = output.New(format)
 
 		// Get server configuration
@@ -77,7 +89,7 @@ func Execute() {
 func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.unraidcli/config.yaml)")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output format: table, json, yaml (default from config or 'table')")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output format: table, json, yaml, or csv where supported (default from config or 'table')")
 	rootCmd.PersistentFlags().StringVarP(&serverName, "server", "s", "", "server profile name (default from config)")
 
 	// Set version template
@@ -9,11 +9,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	powerYes             bool
+	powerGracefulTimeout int
+	powerWaitOnline      bool
+)
+
 // serverCmd represents the server command
 var serverCmd = &cobra.Command{
 	Use:   "server",
-	Short: "Server information and status",
-	Long:  "View Unraid server information, status, and health.",
+	Short: "Server information, status, and power management",
+	Long:  "View Unraid server information, status, and health, and reboot or shut down the server.",
 }
 
 // serverInfoCmd represents the server info command
@@ -95,8 +101,183 @@ var serverStatusCmd = &cobra.Command{
 	},
 }
 
+// serverRebootCmd represents the server reboot command
+var serverRebootCmd = &cobra.Command{
+	Use:   "reboot",
+	Short: "Reboot the server",
+	Long: `Reboot the Unraid server.
+
+Running containers and VMs and any active parity check are listed first, and
+you must type 'reboot' to confirm unless --yes is given.
+
+Use --graceful-timeout to stop containers and VMs in reverse autostart
+order before rebooting, giving up if that takes longer than the given
+number of seconds. Use --wait-online to wait until the API responds again
+and report the downtime.
+
+Examples:
+  unraidcli server reboot
+  unraidcli server reboot --graceful-timeout 300 --wait-online
+  unraidcli server reboot --yes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runServerPower("reboot", apiClient.RebootServer)
+	},
+}
+
+// serverShutdownCmd represents the server shutdown command
+var serverShutdownCmd = &cobra.Command{
+	Use:   "shutdown",
+	Short: "Shut down the server",
+	Long: `Power off the Unraid server.
+
+Running containers and VMs and any active parity check are listed first, and
+you must type 'shutdown' to confirm unless --yes is given.
+
+Use --graceful-timeout to stop containers and VMs in reverse autostart
+order before shutting down, giving up if that takes longer than the given
+number of seconds.
+
+Examples:
+  unraidcli server shutdown
+  unraidcli server shutdown --graceful-timeout 300
+  unraidcli server shutdown --yes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runServerPower("shutdown", apiClient.ShutdownServer)
+	},
+}
+
+// rebootWaitTimeout is how long --wait-online waits for the server to return
+const rebootWaitTimeout = 20 * time.Minute
+
+// runServerPower shows the preflight summary, asks for confirmation,
+// optionally stops workloads, and then reboots or shuts down the server
+func runServerPower(action string, power func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	summary, err := collectPreflight(ctx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("preflight check failed: %w", err)
+	}
+
+	fmt.Println("Preflight check:")
+	summary.Print()
+
+	if !powerYes {
+		if err := confirmTyped(action+" the server", action); err != nil {
+			return err
+		}
+	}
+
+	if powerGracefulTimeout > 0 && !summary.Empty() {
+		fmt.Println("\nStopping workloads...")
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(powerGracefulTimeout)*time.Second)
+		err := summary.StopWorkloads(ctx)
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("graceful stop failed, %s canceled: %w", action, err)
+		}
+	}
+
+	if action == "reboot" {
+		fmt.Println("\nRebooting server...")
+	} else {
+		fmt.Println("\nShutting down server...")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	err = power(ctx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to %s server: %w", action, err)
+	}
+	requested := time.Now()
+
+	if !powerWaitOnline || action != "reboot" {
+		message := "Reboot requested"
+		if action == "shutdown" {
+			message = "Shutdown requested"
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ %s\n", message)
+		} else {
+			formatter.Print(map[string]string{
+				"status":  "success",
+				"message": message,
+			})
+		}
+		return nil
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), rebootWaitTimeout)
+	defer cancel()
+
+	downtime, err := waitForOnline(ctx)
+	if err != nil {
+		return err
+	}
+	total := time.Since(requested).Round(time.Second)
+
+	if outputFormat == "" || outputFormat == "table" {
+		fmt.Printf("✓ Server is back online after %s (API unreachable for %s)\n", total, downtime)
+	} else {
+		formatter.Print(map[string]interface{}{
+			"status":           "success",
+			"message":          "Server is back online",
+			"total_seconds":    int(total.Seconds()),
+			"downtime_seconds": int(downtime.Seconds()),
+		})
+	}
+
+	return nil
+}
+
+// waitForOnline polls the API until the server has gone down and come back
+// up, and returns how long the API was unreachable
+func waitForOnline(ctx context.Context) (time.Duration, error) {
+	fmt.Println("Waiting for the server to go down...")
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	var down time.Time
+	for {
+		reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err := apiClient.TestConnection(reqCtx)
+		cancel()
+
+		if down.IsZero() {
+			if err != nil {
+				down = time.Now()
+				fmt.Println("Server is down, waiting for it to come back online...")
+			}
+		} else if err == nil {
+			return time.Since(down).Round(time.Second), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if down.IsZero() {
+				return 0, fmt.Errorf("timed out waiting for the server to go down")
+			}
+			return 0, fmt.Errorf("timed out waiting for the server to come back online")
+		case <-ticker.C:
+		}
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(serverCmd)
 	serverCmd.AddCommand(serverInfoCmd)
 	serverCmd.AddCommand(serverStatusCmd)
+	serverCmd.AddCommand(serverRebootCmd)
+	serverCmd.AddCommand(serverShutdownCmd)
+
+	for _, cmd := range []*cobra.Command{serverRebootCmd, serverShutdownCmd} {
+		cmd.Flags().BoolVarP(&powerYes, "yes", "y", false, "Skip the confirmation prompt")
+		cmd.Flags().IntVar(&powerGracefulTimeout, "graceful-timeout", 0, "Stop containers and VMs first, waiting at most this many seconds")
+	}
+	serverRebootCmd.Flags().BoolVar(&powerWaitOnline, "wait-online", false, "Wait until the API responds again and report the downtime")
 }
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networkWatch    bool
+	networkInterval int
+)
+
+// networkSample is a set of interfaces read at one point in time
+type networkSample struct {
+	at         time.Time
+	interfaces []client.NetworkInterface
+}
+
+// networkReport is an interface with its throughput in bytes per second
+type networkReport struct {
+	client.NetworkInterface
+	RxRate float64 `json:"rxRate" yaml:"rxRate"`
+	TxRate float64 `json:"txRate" yaml:"txRate"`
+}
+
+// serverNetworkCmd represents the server network command
+var serverNetworkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "Show network interfaces and throughput",
+	Long: `List network interfaces, bonds, bridges, and VLANs with their link state,
+IPv4/IPv6 addresses, link speed, and MTU.
+
+Receive and transmit rates are computed from two samples of the interface
+byte counters taken one second apart, or between refreshes in watch mode.
+
+Examples:
+  unraidcli server network
+  unraidcli server network --watch
+  unraidcli server network -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if networkWatch && networkInterval < 1 {
+			return fmt.Errorf("--interval must be at least 1 second")
+		}
+
+		var previous *networkSample
+
+		networkFunc := func() error {
+			if previous == nil {
+				sample, err := sampleNetwork()
+				if err != nil {
+					return err
+				}
+				previous = sample
+				time.Sleep(time.Second)
+			}
+
+			current, err := sampleNetwork()
+			if err != nil {
+				return err
+			}
+
+			reports := networkRates(previous, current)
+			previous = current
+
+			if outputFormat == "" || outputFormat == "table" {
+				if networkWatch {
+					fmt.Printf("Last updated: %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
+				}
+				printNetwork(reports)
+			} else {
+				formatter.Print(reports)
+			}
+
+			return nil
+		}
+
+		// Watch mode
+		if networkWatch {
+			// Setup signal handling for graceful exit
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sigChan := make(chan os.Signal, 1)
+			signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigChan
+				cancel()
+			}()
+
+			fmt.Println("Press Ctrl+C to exit watch mode")
+			interval := time.Duration(networkInterval) * time.Second
+			return output.Watch(ctx, interval, networkFunc)
+		}
+
+		// Normal mode
+		return networkFunc()
+	},
+}
+
+// sampleNetwork reads the network interfaces and their byte counters
+func sampleNetwork() (*networkSample, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	interfaces, err := apiClient.GetNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network interfaces: %w", err)
+	}
+
+	return &networkSample{at: time.Now(), interfaces: interfaces}, nil
+}
+
+// networkRates computes the throughput of each interface in current from
+// the byte counters in previous. Counters that went backwards (e.g. after
+// an interface was recreated) give a rate of zero.
+func networkRates(previous, current *networkSample) []networkReport {
+	elapsed := current.at.Sub(previous.at).Seconds()
+
+	before := make(map[string]client.NetworkInterface)
+	for _, iface := range previous.interfaces {
+		before[iface.Name] = iface
+	}
+
+	reports := make([]networkReport, 0, len(current.interfaces))
+	for _, iface := range current.interfaces {
+		report := networkReport{NetworkInterface: iface}
+
+		if old, ok := before[iface.Name]; ok && elapsed > 0 {
+			if rx := iface.RxBytes - old.RxBytes; rx > 0 {
+				report.RxRate = float64(rx) / elapsed
+			}
+			if tx := iface.TxBytes - old.TxBytes; tx > 0 {
+				report.TxRate = float64(tx) / elapsed
+			}
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+// printNetwork prints the interface table
+func printNetwork(reports []networkReport) {
+	headers := []string{"Interface", "Type", "State", "Member Of", "IPv4", "IPv6", "Speed", "MTU", "RX", "TX"}
+	var rows [][]string
+
+	for _, report := range reports {
+		ifaceType := report.Type
+		if report.VLANID > 0 {
+			ifaceType = fmt.Sprintf("%s %d", ifaceType, report.VLANID)
+		}
+		if len(report.Members) > 0 {
+			ifaceType = fmt.Sprintf("%s (%s)", ifaceType, strings.Join(report.Members, ", "))
+		}
+
+		rows = append(rows, []string{
+			report.Name,
+			ifaceType,
+			output.ColorizeState(report.State),
+			dashIfEmpty(report.Master),
+			dashIfEmpty(strings.Join(report.IPv4, ", ")),
+			dashIfEmpty(strings.Join(report.IPv6, ", ")),
+			formatLinkSpeed(report.Speed),
+			fmt.Sprintf("%d", report.MTU),
+			formatRate(report.RxRate),
+			formatRate(report.TxRate),
+		})
+	}
+
+	formatter.PrintTable(headers, rows)
+}
+
+// formatLinkSpeed formats a link speed given in Mbps
+func formatLinkSpeed(mbps int) string {
+	switch {
+	case mbps <= 0:
+		return "-"
+	case mbps >= 1000 && mbps%1000 == 0:
+		return fmt.Sprintf("%d Gbps", mbps/1000)
+	case mbps >= 1000:
+		return fmt.Sprintf("%.1f Gbps", float64(mbps)/1000)
+	default:
+		return fmt.Sprintf("%d Mbps", mbps)
+	}
+}
+
+// formatRate formats a throughput in bytes per second
+func formatRate(bytesPerSecond float64) string {
+	return output.FormatBytes(int64(bytesPerSecond)) + "/s"
+}
+
+func init() {
+	serverCmd.AddCommand(serverNetworkCmd)
+
+	serverNetworkCmd.Flags().BoolVarP(&networkWatch, "watch", "w", false, "Watch mode - auto-refresh every N seconds")
+	serverNetworkCmd.Flags().IntVarP(&networkInterval, "interval", "i", 2, "Refresh interval in seconds for watch mode")
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/client"
+	"github.com/01dnot/unraidcli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// upsLowRuntime is the remaining battery runtime below which a UPS is
+// reported with a warning
+const upsLowRuntime = 10 * time.Minute
+
+// upsReport is a flattened UPS status for monitoring
+type upsReport struct {
+	Name           string   `json:"name" yaml:"name"`
+	Model          string   `json:"model" yaml:"model"`
+	Status         string   `json:"status" yaml:"status"`
+	OnBattery      bool     `json:"onBattery" yaml:"onBattery"`
+	BatteryCharge  int      `json:"batteryCharge" yaml:"batteryCharge"`
+	BatteryHealth  string   `json:"batteryHealth" yaml:"batteryHealth"`
+	RuntimeSeconds int      `json:"runtimeSeconds" yaml:"runtimeSeconds"`
+	LoadPercent    float64  `json:"loadPercent" yaml:"loadPercent"`
+	InputVoltage   float64  `json:"inputVoltage" yaml:"inputVoltage"`
+	OutputVoltage  float64  `json:"outputVoltage" yaml:"outputVoltage"`
+	Warnings       []string `json:"warnings" yaml:"warnings"`
+}
+
+// serverUPSCmd represents the server ups command
+var serverUPSCmd = &cobra.Command{
+	Use:   "ups",
+	Short: "Show UPS status",
+	Long: `Display battery charge, remaining runtime, load, input voltage, and
+on-battery state of the UPS devices configured in Unraid.
+
+A warning is shown when a UPS is on battery, reports any status other than
+ONLINE (e.g. LOWBATT, OVERLOAD, REPLBATT, COMMLOST), or has less than 10
+minutes of runtime left. The same checks are part of 'unraidcli health'. Use -o json for
+flat numeric fields suitable for monitoring.
+
+Examples:
+  unraidcli server ups
+  unraidcli server ups -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		devices, err := apiClient.GetUPSDevices(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get UPS status: %w", err)
+		}
+
+		reports := make([]upsReport, 0, len(devices))
+		for _, device := range devices {
+			reports = append(reports, newUPSReport(device))
+		}
+
+		if outputFormat != "" && outputFormat != "table" {
+			formatter.Print(reports)
+			return nil
+		}
+
+		if len(reports) == 0 {
+			fmt.Println("No UPS configured")
+			return nil
+		}
+
+		headers := []string{"Name", "Model", "Status", "Charge", "Runtime", "Load", "Input"}
+		var rows [][]string
+
+		for _, report := range reports {
+			status := output.Green(report.Status)
+			if len(report.Warnings) > 0 {
+				status = output.Yellow(report.Status)
+			}
+
+			rows = append(rows, []string{
+				report.Name,
+				report.Model,
+				status,
+				fmt.Sprintf("%d%%", report.BatteryCharge),
+				(time.Duration(report.RuntimeSeconds) * time.Second).String(),
+				output.ColorizePercentage(report.LoadPercent, false),
+				fmt.Sprintf("%.1f V", report.InputVoltage),
+			})
+		}
+
+		formatter.PrintTable(headers, rows)
+
+		for _, report := range reports {
+			for _, warning := range report.Warnings {
+				fmt.Println(output.Warning(fmt.Sprintf("%s: %s", report.Name, warning)))
+			}
+		}
+
+		return nil
+	},
+}
+
+// newUPSReport flattens a UPS device and checks it for warnings
+func newUPSReport(device client.UPSDevice) upsReport {
+	return upsReport{
+		Name:           device.Name,
+		Model:          device.Model,
+		Status:         strings.ToUpper(device.Status),
+		OnBattery:      device.OnBattery(),
+		BatteryCharge:  device.Battery.ChargeLevel,
+		BatteryHealth:  device.Battery.Health,
+		RuntimeSeconds: device.Battery.EstimatedRuntime,
+		LoadPercent:    device.Power.LoadPercentage,
+		InputVoltage:   device.Power.InputVoltage,
+		OutputVoltage:  device.Power.OutputVoltage,
+		Warnings:       upsWarnings(device),
+	}
+}
+
+// upsWarnings returns the problems to report for a UPS
+func upsWarnings(device client.UPSDevice) []string {
+	warnings := []string{}
+
+	if device.OnBattery() {
+		warnings = append(warnings, "running on battery")
+	} else if !device.OnLine() {
+		warnings = append(warnings, fmt.Sprintf("status %s", dashIfEmpty(strings.ToUpper(device.Status))))
+	}
+
+	runtime := time.Duration(device.Battery.EstimatedRuntime) * time.Second
+	if runtime > 0 && runtime < upsLowRuntime {
+		warnings = append(warnings, fmt.Sprintf("low battery runtime (%s left)", runtime))
+	}
+
+	return warnings
+}
+
+func init() {
+	serverCmd.AddCommand(serverUPSCmd)
+}
@@ -3,6 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/01dnot/unraidcli/internal/client"
@@ -10,11 +13,33 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	shareComment     string
+	shareAllocator   string
+	shareSplitLevel  string
+	shareMinFree     string
+	shareInclude     []string
+	shareExclude     []string
+	shareCache       string
+	sharePool        string
+	shareSMB         string
+	shareSMBSecurity string
+	shareNFS         string
+	shareNFSSecurity string
+	shareDryRun      bool
+	shareYes         bool
+
+	accessUsers []string
+	accessRW    bool
+	accessRO    bool
+	accessNone  bool
+)
+
 // sharesCmd represents the shares command
 var sharesCmd = &cobra.Command{
 	Use:   "shares",
 	Short: "Manage user shares",
-	Long:  "List and view information about user shares on your Unraid server.",
+	Long:  "List, view, create, update, and delete user shares on your Unraid server.",
 }
 
 // sharesLsCmd represents the shares ls command
@@ -26,7 +51,7 @@ var sharesLsCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		shares, err := apiClient.GetShares(ctx)
+		shares, err := getSharesWithSettings(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get shares: %w", err)
 		}
@@ -37,7 +62,7 @@ var sharesLsCmd = &cobra.Command{
 		}
 
 		if outputFormat == "" || outputFormat == "table" {
-			headers := []string{"Name", "Total", "Used", "Free", "% Used", "Cache", "Comment"}
+			headers := []string{"Name", "Total", "Used", "Free", "% Used", "Cache", "On Cache", "Comment"}
 			var rows [][]string
 
 			for _, share := range shares {
@@ -51,6 +76,11 @@ var sharesLsCmd = &cobra.Command{
 					cache = "✓"
 				}
 
+				onCache := ""
+				if share.CacheUsed > 0 {
+					onCache = output.FormatBytes(share.CacheUsed)
+				}
+
 				comment := share.Comment
 				if len(comment) > 30 {
 					comment = comment[:27] + "..."
@@ -63,6 +93,7 @@ var sharesLsCmd = &cobra.Command{
 					output.FormatBytes(share.Free),
 					usedPercent,
 					cache,
+					onCache,
 					comment,
 				})
 			}
@@ -88,7 +119,7 @@ var sharesInfoCmd = &cobra.Command{
 
 		shareName := args[0]
 
-		shares, err := apiClient.GetShares(ctx)
+		shares, err := getSharesWithSettings(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get shares: %w", err)
 		}
@@ -119,10 +150,16 @@ var sharesInfoCmd = &cobra.Command{
 				"Used":          fmt.Sprintf("%s (%.1f%%)", output.FormatBytes(found.Used), usedPercent),
 				"Free":          output.FormatBytes(found.Free),
 				"Cache":         found.Cache,
+				"On Cache":      output.FormatBytes(found.CacheUsed),
 				"Comment":       found.Comment,
 				"Include Disks": fmt.Sprintf("%v", found.Include),
 				"Exclude Disks": fmt.Sprintf("%v", found.Exclude),
 			}
+			for _, setting := range shareSettings(found) {
+				if _, ok := data[setting.Name]; !ok && setting.Value != "" {
+					data[setting.Name] = setting.Value
+				}
+			}
 			formatter.PrintKeyValue(data)
 		} else {
 			formatter.Print(found)
@@ -132,8 +169,892 @@ var sharesInfoCmd = &cobra.Command{
 	},
 }
 
+// sharesCreateCmd represents the shares create command
+var sharesCreateCmd = &cobra.Command{
+	Use:   "create <share-name>",
+	Short: "Create a user share",
+	Long: `Create a user share. Settings that are not given use the server defaults.
+
+Examples:
+  unraidcli shares create media --allocator highwater --split-level 1 --min-free 50G
+  unraidcli shares create backups --include disk1,disk2 --cache yes --pool cache
+  unraidcli shares create scratch --cache only --smb yes --smb-security private
+  unraidcli shares create media --include disk1 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		input, err := buildShareInput(cmd)
+		if err != nil {
+			return err
+		}
+		input.Name = args[0]
+
+		if shareDryRun {
+			proposed := applyShareInput(client.Share{Name: args[0]}, input)
+			return printShareChanges(nil, &proposed)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("Creating share '%s'...\n", args[0])
+		}
+
+		share, err := apiClient.CreateShare(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to create share: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Share '%s' created successfully\n", share.Name)
+		} else {
+			formatter.Print(share)
+		}
+
+		return nil
+	},
+}
+
+// sharesUpdateCmd represents the shares update command
+var sharesUpdateCmd = &cobra.Command{
+	Use:   "update <share-name>",
+	Short: "Change the settings of a user share",
+	Long: `Change the settings of a user share. Only the settings given as flags are
+changed. Use --dry-run to show the changes without applying them.
+
+Examples:
+  unraidcli shares update media --cache prefer
+  unraidcli shares update media --exclude disk3 --min-free 100G
+  unraidcli shares update media --include "" # Allow all disks
+  unraidcli shares update media --nfs yes --nfs-security secure --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		input, err := buildShareInput(cmd)
+		if err != nil {
+			return err
+		}
+
+		current, err := apiClient.GetShare(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get share: %w", err)
+		}
+
+		proposed := applyShareInput(*current, input)
+
+		if shareDryRun {
+			return printShareChanges(current, &proposed)
+		}
+
+		if len(diffShares(current, &proposed)) == 0 {
+			if outputFormat == "" || outputFormat == "table" {
+				fmt.Println("No changes to apply.")
+			} else {
+				formatter.Print(current)
+			}
+			return nil
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("Updating share '%s'...\n", args[0])
+		}
+
+		share, err := apiClient.UpdateShare(ctx, args[0], input)
+		if err != nil {
+			return fmt.Errorf("failed to update share: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Share '%s' updated successfully\n", share.Name)
+		} else {
+			formatter.Print(share)
+		}
+
+		return nil
+	},
+}
+
+// sharesDeleteCmd represents the shares delete command
+var sharesDeleteCmd = &cobra.Command{
+	Use:   "delete <share-name>",
+	Short: "Delete an empty user share",
+	Long: `Delete a user share. Only empty shares can be deleted; move the data off
+the share first. Asks for the share name as confirmation unless --yes is given.
+
+Examples:
+  unraidcli shares delete scratch
+  unraidcli shares delete scratch --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		share, err := apiClient.GetShare(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get share: %w", err)
+		}
+
+		if share.Used > 0 {
+			return fmt.Errorf("share '%s' still contains %s of data; move it off the share first", share.Name, output.FormatBytes(share.Used))
+		}
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		if shareDryRun {
+			if table {
+				fmt.Printf("Would delete share '%s'\n", share.Name)
+			} else {
+				formatter.Print(share)
+			}
+			return nil
+		}
+
+		if !shareYes {
+			if err := confirmTyped("delete the share", share.Name); err != nil {
+				return err
+			}
+		}
+
+		if table {
+			fmt.Printf("Deleting share '%s'...\n", share.Name)
+		}
+
+		if err := apiClient.DeleteShare(ctx, share.Name); err != nil {
+			return fmt.Errorf("failed to delete share: %w", err)
+		}
+
+		if table {
+			fmt.Printf("✓ Share '%s' deleted successfully\n", share.Name)
+		} else {
+			formatter.Print(map[string]string{
+				"status":  "success",
+				"message": "Share deleted successfully",
+				"share":   share.Name,
+			})
+		}
+		return nil
+	},
+}
+
+// getSharesWithSettings retrieves the shares with their settings and cache
+// usage, or only the basic share fields if the server does not support them
+func getSharesWithSettings(ctx context.Context) ([]client.Share, error) {
+	if shares, err := apiClient.GetShareSettings(ctx); err == nil {
+		return shares, nil
+	}
+	return apiClient.GetShares(ctx)
+}
+
+// buildShareInput collects the share settings given as flags
+func buildShareInput(cmd *cobra.Command) (client.ShareInput, error) {
+	var input client.ShareInput
+	flags := cmd.Flags()
+
+	choice := func(flag string, value string, allowed ...string) (*string, error) {
+		value = strings.ToLower(value)
+		for _, a := range allowed {
+			if value == a {
+				return &value, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid --%s %q (use %s)", flag, value, strings.Join(allowed, ", "))
+	}
+
+	var err error
+	if flags.Changed("comment") {
+		input.Comment = &shareComment
+	}
+	if flags.Changed("allocator") {
+		if input.Allocator, err = choice("allocator", shareAllocator, "highwater", "mostfree", "fillup"); err != nil {
+			return input, err
+		}
+	}
+	if flags.Changed("split-level") {
+		input.SplitLevel = &shareSplitLevel
+	}
+	if flags.Changed("min-free") {
+		bytes, err := output.ParseBytes(shareMinFree)
+		if err != nil {
+			return input, fmt.Errorf("invalid --min-free: %w", err)
+		}
+		floor := client.Kilobytes(bytes / 1024)
+		input.Floor = &floor
+	}
+	if flags.Changed("include") {
+		include := nonEmpty(shareInclude)
+		input.Include = &include
+	}
+	if flags.Changed("exclude") {
+		exclude := nonEmpty(shareExclude)
+		input.Exclude = &exclude
+	}
+	if flags.Changed("cache") {
+		if input.UseCache, err = choice("cache", shareCache, "yes", "no", "prefer", "only"); err != nil {
+			return input, err
+		}
+	}
+	if flags.Changed("pool") {
+		input.CachePool = &sharePool
+	}
+	if flags.Changed("smb") {
+		if input.SMBExport, err = choice("smb", shareSMB, "yes", "no", "hidden"); err != nil {
+			return input, err
+		}
+	}
+	if flags.Changed("smb-security") {
+		if input.SMBSecurity, err = choice("smb-security", shareSMBSecurity, "public", "secure", "private"); err != nil {
+			return input, err
+		}
+	}
+	if flags.Changed("nfs") {
+		if input.NFSExport, err = choice("nfs", shareNFS, "yes", "no"); err != nil {
+			return input, err
+		}
+	}
+	if flags.Changed("nfs-security") {
+		if input.NFSSecurity, err = choice("nfs-security", shareNFSSecurity, "public", "secure", "private"); err != nil {
+			return input, err
+		}
+	}
+
+	return input, nil
+}
+
+// nonEmpty returns values without empty strings, so that --include ""
+// clears the list
+func nonEmpty(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// applyShareInput returns share with the settings from input applied
+func applyShareInput(share client.Share, input client.ShareInput) client.Share {
+	if input.Comment != nil {
+		share.Comment = *input.Comment
+	}
+	if input.Allocator != nil {
+		share.Allocator = *input.Allocator
+	}
+	if input.SplitLevel != nil {
+		share.SplitLevel = *input.SplitLevel
+	}
+	if input.Floor != nil {
+		share.Floor = *input.Floor
+	}
+	if input.Include != nil {
+		share.Include = *input.Include
+	}
+	if input.Exclude != nil {
+		share.Exclude = *input.Exclude
+	}
+	if input.UseCache != nil {
+		share.UseCache = *input.UseCache
+	}
+	if input.CachePool != nil {
+		share.CachePool = *input.CachePool
+	}
+	if input.SMBExport != nil {
+		share.SMB.Export = *input.SMBExport
+	}
+	if input.SMBSecurity != nil {
+		share.SMB.Security = *input.SMBSecurity
+	}
+	if input.NFSExport != nil {
+		share.NFS.Export = *input.NFSExport
+	}
+	if input.NFSSecurity != nil {
+		share.NFS.Security = *input.NFSSecurity
+	}
+	return share
+}
+
+// shareSetting is a named, formatted share setting
+type shareSetting struct {
+	Name  string
+	Value string
+}
+
+// shareSettings returns the configurable settings of a share, in display order
+func shareSettings(share *client.Share) []shareSetting {
+	floor := ""
+	if share.Floor > 0 {
+		floor = output.FormatBytes(share.Floor.Bytes())
+	}
+
+	return []shareSetting{
+		{"Comment", share.Comment},
+		{"Allocation Method", share.Allocator},
+		{"Split Level", share.SplitLevel},
+		{"Minimum Free Space", floor},
+		{"Include Disks", strings.Join(share.Include, ",")},
+		{"Exclude Disks", strings.Join(share.Exclude, ",")},
+		{"Use Cache", share.UseCache},
+		{"Cache Pool", share.CachePool},
+		{"SMB Export", share.SMB.Export},
+		{"SMB Security", share.SMB.Security},
+		{"NFS Export", share.NFS.Export},
+		{"NFS Security", share.NFS.Security},
+	}
+}
+
+// shareChange is a share setting that differs between two versions of a share
+type shareChange struct {
+	Setting string `json:"setting" yaml:"setting"`
+	Current string `json:"current" yaml:"current"`
+	New     string `json:"new" yaml:"new"`
+}
+
+// diffShares lists the settings that differ between current and proposed.
+// A nil current share (i.e. a new share) lists every non-empty setting.
+func diffShares(current *client.Share, proposed *client.Share) []shareChange {
+	changes := []shareChange{}
+
+	newSettings := shareSettings(proposed)
+	for i, setting := range newSettings {
+		old := ""
+		if current != nil {
+			old = shareSettings(current)[i].Value
+		}
+		if old != setting.Value {
+			changes = append(changes, shareChange{Setting: setting.Name, Current: old, New: setting.Value})
+		}
+	}
+
+	return changes
+}
+
+// printShareChanges prints the changes a create or update would make
+func printShareChanges(current *client.Share, proposed *client.Share) error {
+	changes := diffShares(current, proposed)
+
+	if outputFormat != "" && outputFormat != "table" {
+		return formatter.Print(changes)
+	}
+
+	if current == nil {
+		fmt.Printf("Would create share '%s'\n", proposed.Name)
+	} else if len(changes) == 0 {
+		fmt.Printf("No changes to share '%s'\n", current.Name)
+		return nil
+	} else {
+		fmt.Printf("Would update share '%s'\n", current.Name)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	headers := []string{"Setting", "Current", "New"}
+	var rows [][]string
+
+	for _, change := range changes {
+		old := change.Current
+		if old == "" {
+			old = "-"
+		}
+		rows = append(rows, []string{change.Setting, output.Red(old), output.Green(change.New)})
+	}
+
+	formatter.PrintTable(headers, rows)
+	return nil
+}
+
+// sharesDistributionCmd represents the shares distribution command
+var sharesDistributionCmd = &cobra.Command{
+	Use:   "distribution [share-name]",
+	Short: "Show where share data lives",
+	Long: `Show how much of each share is stored on each array disk and pool, as a
+share-by-disk matrix. With a share name, show the breakdown for that share.
+
+Data on an array disk that the share's include/exclude settings do not allow
+is flagged; this happens when settings change after data was written.
+
+Examples:
+  unraidcli shares distribution
+  unraidcli shares distribution media
+  unraidcli shares distribution -o json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		distributions, err := apiClient.GetShareDistribution(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get share distribution: %w", err)
+		}
+
+		var reports []shareDistributionReport
+		for _, distribution := range distributions {
+			if len(args) == 1 && distribution.Name != args[0] {
+				continue
+			}
+			reports = append(reports, newShareDistributionReport(distribution))
+		}
+
+		if len(reports) == 0 {
+			if len(args) == 1 {
+				return fmt.Errorf("share '%s' not found", args[0])
+			}
+			fmt.Println("No shares found.")
+			return nil
+		}
+
+		if outputFormat != "" && outputFormat != "table" {
+			if len(args) == 1 {
+				return formatter.Print(reports[0])
+			}
+			return formatter.Print(reports)
+		}
+
+		if len(args) == 1 {
+			printShareDistribution(reports[0])
+			return nil
+		}
+
+		// Columns: every disk or pool that holds data of any share
+		seen := make(map[string]bool)
+		var disks []string
+		for _, report := range reports {
+			for _, usage := range report.Disks {
+				if !seen[usage.Disk] {
+					seen[usage.Disk] = true
+					disks = append(disks, usage.Disk)
+				}
+			}
+		}
+		sort.Slice(disks, func(i, j int) bool {
+			return diskLess(disks[i], disks[j])
+		})
+
+		headers := append([]string{"Share"}, disks...)
+		headers = append(headers, "Total")
+		var rows [][]string
+		flagged := false
+
+		for _, report := range reports {
+			used := make(map[string]int64)
+			for _, usage := range report.Disks {
+				used[usage.Disk] = usage.Used
+			}
+			unexpected := make(map[string]bool)
+			for _, disk := range report.Unexpected {
+				unexpected[disk] = true
+			}
+
+			row := []string{report.Share}
+			for _, disk := range disks {
+				switch {
+				case used[disk] == 0:
+					row = append(row, output.Gray("-"))
+				case unexpected[disk]:
+					row = append(row, output.Yellow(output.FormatBytes(used[disk])+" ⚠"))
+					flagged = true
+				default:
+					row = append(row, output.FormatBytes(used[disk]))
+				}
+			}
+			row = append(row, output.FormatBytes(report.Total))
+			rows = append(rows, row)
+		}
+
+		formatter.PrintTable(headers, rows)
+
+		if flagged {
+			fmt.Printf("\n%s\n", output.Warning("Data on disks outside the share's include/exclude settings"))
+		}
+
+		return nil
+	},
+}
+
+// shareDistributionReport is a share's data per disk, with the disks that
+// hold data the share's settings do not allow
+type shareDistributionReport struct {
+	Share      string                  `json:"share" yaml:"share"`
+	Total      int64                   `json:"total" yaml:"total"`
+	Disks      []client.ShareDiskUsage `json:"disks" yaml:"disks"`
+	Unexpected []string                `json:"unexpected" yaml:"unexpected"`
+}
+
+// newShareDistributionReport builds a report from a share's distribution,
+// skipping disks without data
+func newShareDistributionReport(distribution client.ShareDistribution) shareDistributionReport {
+	report := shareDistributionReport{
+		Share:      distribution.Name,
+		Disks:      []client.ShareDiskUsage{},
+		Unexpected: []string{},
+	}
+
+	for _, usage := range distribution.Disks {
+		if usage.Used <= 0 {
+			continue
+		}
+		report.Disks = append(report.Disks, usage)
+		report.Total += usage.Used
+		if !diskAllowed(usage.Disk, distribution.Include, distribution.Exclude) {
+			report.Unexpected = append(report.Unexpected, usage.Disk)
+		}
+	}
+
+	sort.Slice(report.Disks, func(i, j int) bool {
+		return diskLess(report.Disks[i].Disk, report.Disks[j].Disk)
+	})
+
+	return report
+}
+
+// printShareDistribution prints the per-disk breakdown of a single share
+func printShareDistribution(report shareDistributionReport) {
+	if len(report.Disks) == 0 {
+		fmt.Printf("Share '%s' holds no data.\n", report.Share)
+		return
+	}
+
+	unexpected := make(map[string]bool)
+	for _, disk := range report.Unexpected {
+		unexpected[disk] = true
+	}
+
+	headers := []string{"Disk", "Used", "% of Share", "Allowed"}
+	var rows [][]string
+
+	for _, usage := range report.Disks {
+		allowed := output.Green("✓")
+		if unexpected[usage.Disk] {
+			allowed = output.Yellow("⚠ no")
+		}
+
+		rows = append(rows, []string{
+			usage.Disk,
+			output.FormatBytes(usage.Used),
+			fmt.Sprintf("%.1f%%", float64(usage.Used)/float64(report.Total)*100),
+			allowed,
+		})
+	}
+
+	formatter.PrintTable(headers, rows)
+	fmt.Printf("\nTotal: %s on %d disk(s)\n", output.FormatBytes(report.Total), len(report.Disks))
+}
+
+// diskAllowed reports whether a share's include/exclude settings allow data
+// on disk. Pools are not governed by these settings and are always allowed.
+func diskAllowed(disk string, include []string, exclude []string) bool {
+	if _, ok := arrayDiskNumber(disk); !ok {
+		return true
+	}
+
+	for _, excluded := range exclude {
+		if excluded == disk {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+	for _, included := range include {
+		if included == disk {
+			return true
+		}
+	}
+	return false
+}
+
+// arrayDiskNumber returns N for an array data disk named "diskN"
+func arrayDiskNumber(name string) (int, bool) {
+	if !strings.HasPrefix(name, "disk") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(name, "disk"))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// diskLess orders array disks numerically (disk2 before disk10), followed by
+// pools in alphabetical order
+func diskLess(a string, b string) bool {
+	na, aIsDisk := arrayDiskNumber(a)
+	nb, bIsDisk := arrayDiskNumber(b)
+
+	switch {
+	case aIsDisk && bIsDisk:
+		return na < nb
+	case aIsDisk != bIsDisk:
+		return aIsDisk
+	default:
+		return a < b
+	}
+}
+
+// sharesExportsCmd represents the shares exports command
+var sharesExportsCmd = &cobra.Command{
+	Use:   "exports [share-name]",
+	Short: "Show SMB/NFS exports and user access",
+	Long: `Show how shares are exported over SMB and NFS, their security mode
+(public, secure, or private), and each user's access. Without a share name,
+all shares are shown.
+
+Use -o csv or -o json to produce a report for access audits; CSV output has
+one row per share and user.
+
+Examples:
+  unraidcli shares exports media
+  unraidcli shares exports -o csv > share-access.csv
+  unraidcli shares exports -o json`,
+	Args:        cobra.MaximumNArgs(1),
+	Annotations: map[string]string{csvAnnotation: "true"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		all, err := apiClient.GetShareExports(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get share exports: %w", err)
+		}
+
+		var exports []client.ShareExports
+		for _, export := range all {
+			if len(args) == 0 || export.Name == args[0] {
+				exports = append(exports, export)
+			}
+		}
+
+		if len(exports) == 0 {
+			if len(args) == 1 {
+				return fmt.Errorf("share '%s' not found", args[0])
+			}
+			fmt.Println("No shares found.")
+			return nil
+		}
+
+		switch outputFormat {
+		case "", "table":
+			for i, export := range exports {
+				if i > 0 {
+					fmt.Println()
+				}
+				printShareExports(export)
+			}
+		case "csv":
+			headers := []string{"Share", "SMB Export", "SMB Security", "NFS Export", "NFS Security", "NFS Rule", "User", "Access"}
+			var rows [][]string
+
+			for _, export := range exports {
+				base := []string{export.Name, export.SMB.Export, export.SMB.Security, export.NFS.Export, export.NFS.Security, export.NFS.Rule}
+				if len(export.SMB.Users) == 0 {
+					rows = append(rows, append(base, "", ""))
+				}
+				for _, user := range export.SMB.Users {
+					rows = append(rows, append(append([]string{}, base...), user.User, user.Access))
+				}
+			}
+
+			formatter.PrintTable(headers, rows)
+		default:
+			if len(args) == 1 {
+				return formatter.Print(exports[0])
+			}
+			return formatter.Print(exports)
+		}
+
+		return nil
+	},
+}
+
+// printShareExports prints the export settings and user access of a share
+func printShareExports(export client.ShareExports) {
+	fmt.Printf("Share: %s\n", export.Name)
+	fmt.Printf("SMB: %s (security: %s)\n", formatExport(export.SMB.Export), formatSecurity(export.SMB.Security))
+
+	nfs := fmt.Sprintf("NFS: %s (security: %s)", formatExport(export.NFS.Export), formatSecurity(export.NFS.Security))
+	if export.NFS.Rule != "" {
+		nfs += fmt.Sprintf(", rule: %s", export.NFS.Rule)
+	}
+	fmt.Println(nfs)
+
+	if len(export.SMB.Users) == 0 {
+		return
+	}
+
+	if strings.EqualFold(export.SMB.Security, "public") {
+		fmt.Println(output.Gray("User access does not apply while SMB security is public"))
+	}
+
+	headers := []string{"User", "Access"}
+	var rows [][]string
+
+	for _, user := range export.SMB.Users {
+		rows = append(rows, []string{user.User, formatAccess(user.Access)})
+	}
+
+	formatter.PrintTable(headers, rows)
+}
+
+// formatExport colors an export setting
+func formatExport(export string) string {
+	switch strings.ToLower(export) {
+	case "yes":
+		return output.Green("exported")
+	case "hidden":
+		return output.Green("exported (hidden)")
+	case "", "no":
+		return output.Gray("not exported")
+	default:
+		return export
+	}
+}
+
+// formatSecurity colors a security mode, highlighting public shares
+func formatSecurity(security string) string {
+	switch strings.ToLower(security) {
+	case "public":
+		return output.Yellow(security)
+	case "":
+		return "-"
+	default:
+		return security
+	}
+}
+
+// formatAccess colors a user's access level
+func formatAccess(access string) string {
+	switch access {
+	case "read-write":
+		return output.Green(access)
+	case "read-only":
+		return output.Cyan(access)
+	default:
+		return output.Gray(access)
+	}
+}
+
+// sharesAccessCmd represents the shares access command
+var sharesAccessCmd = &cobra.Command{
+	Use:   "access",
+	Short: "Manage user access to shares",
+	Long:  "Change which users can read or write a share exported with secure or private security.",
+}
+
+// sharesAccessSetCmd represents the shares access set command
+var sharesAccessSetCmd = &cobra.Command{
+	Use:   "set <share-name>",
+	Short: "Set user access to a share",
+	Long: `Set one or more users' access to a share: read-write (--rw), read-only
+(--ro), or no access (--none). Access applies to SMB exports with secure or
+private security.
+
+Examples:
+  unraidcli shares access set media --user alice --rw
+  unraidcli shares access set media --user bob,carol --ro
+  unraidcli shares access set finance --user guest --none`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		users := nonEmpty(accessUsers)
+		if len(users) == 0 {
+			return fmt.Errorf("at least one --user is required")
+		}
+
+		var access string
+		count := 0
+		if accessRW {
+			access = "read-write"
+			count++
+		}
+		if accessRO {
+			access = "read-only"
+			count++
+		}
+		if accessNone {
+			access = "no-access"
+			count++
+		}
+		if count != 1 {
+			return fmt.Errorf("specify exactly one of --rw, --ro, or --none")
+		}
+
+		shareName := args[0]
+		table := outputFormat == "" || outputFormat == "table"
+		var failed []string
+		updated := []client.ShareUserAccess{}
+
+		for _, user := range users {
+			if table {
+				fmt.Printf("  Setting %s access for '%s' on '%s'... ", access, user, shareName)
+			}
+			if err := apiClient.SetShareUserAccess(ctx, shareName, user, access); err != nil {
+				if table {
+					fmt.Printf("✗ Failed: %v\n", err)
+				}
+				failed = append(failed, user)
+			} else {
+				if table {
+					fmt.Printf("✓\n")
+				}
+				updated = append(updated, client.ShareUserAccess{User: user, Access: access})
+			}
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to set access for %d user(s): %v", len(failed), failed)
+		}
+
+		if !table {
+			formatter.Print(map[string]interface{}{
+				"status":  "success",
+				"message": "Share access updated successfully",
+				"share":   shareName,
+				"users":   updated,
+			})
+		}
+
+		return nil
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(sharesCmd)
 	sharesCmd.AddCommand(sharesLsCmd)
 	sharesCmd.AddCommand(sharesInfoCmd)
+	sharesCmd.AddCommand(sharesCreateCmd)
+	sharesCmd.AddCommand(sharesUpdateCmd)
+	sharesCmd.AddCommand(sharesDeleteCmd)
+	sharesCmd.AddCommand(sharesDistributionCmd)
+	sharesCmd.AddCommand(sharesExportsCmd)
+	sharesCmd.AddCommand(sharesAccessCmd)
+	sharesAccessCmd.AddCommand(sharesAccessSetCmd)
+
+	// Add flags
+	for _, c := range []*cobra.Command{sharesCreateCmd, sharesUpdateCmd} {
+		c.Flags().StringVar(&shareComment, "comment", "", "Share comment")
+		c.Flags().StringVar(&shareAllocator, "allocator", "", "Allocation method: highwater, mostfree, fillup")
+		c.Flags().StringVar(&shareSplitLevel, "split-level", "", "Split level (directory depth that may be split across disks)")
+		c.Flags().StringVar(&shareMinFree, "min-free", "", "Minimum free space per disk (e.g. 50G)")
+		c.Flags().StringSliceVar(&shareInclude, "include", nil, "Disks the share may use (comma-separated)")
+		c.Flags().StringSliceVar(&shareExclude, "exclude", nil, "Disks the share must not use (comma-separated)")
+		c.Flags().StringVar(&shareCache, "cache", "", "Cache pool usage: yes, no, prefer, only")
+		c.Flags().StringVar(&sharePool, "pool", "", "Cache pool to use")
+		c.Flags().StringVar(&shareSMB, "smb", "", "SMB export: yes, no, hidden")
+		c.Flags().StringVar(&shareSMBSecurity, "smb-security", "", "SMB security: public, secure, private")
+		c.Flags().StringVar(&shareNFS, "nfs", "", "NFS export: yes, no")
+		c.Flags().StringVar(&shareNFSSecurity, "nfs-security", "", "NFS security: public, secure, private")
+		c.Flags().BoolVar(&shareDryRun, "dry-run", false, "Show the changes without applying them")
+	}
+	sharesDeleteCmd.Flags().BoolVar(&shareDryRun, "dry-run", false, "Show what would be deleted")
+	sharesDeleteCmd.Flags().BoolVarP(&shareYes, "yes", "y", false, "Skip the confirmation prompt")
+	sharesAccessSetCmd.Flags().StringSliceVarP(&accessUsers, "user", "u", nil, "User(s) to change (comma-separated or repeated)")
+	sharesAccessSetCmd.Flags().BoolVar(&accessRW, "rw", false, "Grant read-write access")
+	sharesAccessSetCmd.Flags().BoolVar(&accessRO, "ro", false, "Grant read-only access")
+	sharesAccessSetCmd.Flags().BoolVar(&accessNone, "none", false, "Revoke access")
 }
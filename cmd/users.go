@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var (
+	userDescription string
+	userYes         bool
+)
+
+// userNamePattern matches valid Unraid user names
+var userNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_-]{0,31}$`)
+
+// usersCmd represents the users command
+var usersCmd = &cobra.Command{
+	Use:     "users",
+	Aliases: []string{"user"},
+	Short:   "Manage user accounts",
+	Long: `List, add, and delete Unraid user accounts and change their passwords.
+
+Passwords are never accepted as flags. They are prompted for when running in
+a terminal, or read from the first line of stdin otherwise, e.g.:
+
+  echo "$PASSWORD" | unraidcli users add alice --description "Alice"`,
+}
+
+// usersListCmd represents the users ls command
+var usersListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List user accounts",
+	Long:    "List user accounts with their descriptions and roles.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		users, err := apiClient.GetUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get users: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			if len(users) == 0 {
+				fmt.Println("No users found")
+				return nil
+			}
+
+			headers := []string{"Name", "Description", "Roles"}
+			var rows [][]string
+
+			for _, user := range users {
+				rows = append(rows, []string{
+					user.Name,
+					dashIfEmpty(user.Description),
+					dashIfEmpty(strings.Join(user.Roles, ", ")),
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+		} else {
+			formatter.Print(users)
+		}
+
+		return nil
+	},
+}
+
+// usersAddCmd represents the users add command
+var usersAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a user account",
+	Long: `Add a user account, e.g. for share access. User names must be lowercase,
+start with a letter or underscore, and be at most 32 characters long.
+
+The password is prompted for in a terminal, or read from stdin.
+
+Examples:
+  unraidcli users add alice --description "Alice (laptop backups)"
+  echo "$PASSWORD" | unraidcli users add backup`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		if !userNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid user name '%s' (use lowercase letters, digits, '-' and '_', starting with a letter or '_')", name)
+		}
+
+		password, err := readPassword(fmt.Sprintf("Password for '%s'", name))
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		user, err := apiClient.AddUser(ctx, name, password, userDescription)
+		if err != nil {
+			return fmt.Errorf("failed to add user: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ User '%s' added\n", user.Name)
+		} else {
+			formatter.Print(user)
+		}
+
+		return nil
+	},
+}
+
+// usersDeleteCmd represents the users delete command
+var usersDeleteCmd = &cobra.Command{
+	Use:     "delete <name>...",
+	Aliases: []string{"rm"},
+	Short:   "Delete user accounts",
+	Long: `Delete one or more user accounts. You must type 'delete' to confirm unless
+--yes is given. The root account cannot be deleted.
+
+Examples:
+  unraidcli users delete alice
+  unraidcli users rm alice bob --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range args {
+			if name == "root" {
+				return fmt.Errorf("the root user cannot be deleted")
+			}
+		}
+
+		if !userYes {
+			fmt.Printf("Users to delete: %s\n", strings.Join(args, ", "))
+			if err := confirmTyped(fmt.Sprintf("delete %d user(s)", len(args)), "delete"); err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		table := outputFormat == "" || outputFormat == "table"
+
+		var failed []string
+		for _, name := range args {
+			if table {
+				fmt.Printf("  Deleting user '%s'... ", name)
+			}
+			if err := apiClient.DeleteUser(ctx, name); err != nil {
+				if table {
+					fmt.Printf("✗ Failed: %v\n", err)
+				}
+				failed = append(failed, name)
+			} else if table {
+				fmt.Printf("✓\n")
+			}
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to delete %d user(s): %v", len(failed), failed)
+		}
+
+		if !table {
+			formatter.Print(map[string]interface{}{
+				"status":  "success",
+				"message": "Users deleted successfully",
+				"users":   args,
+			})
+		}
+
+		return nil
+	},
+}
+
+// usersPasswdCmd represents the users passwd command
+var usersPasswdCmd = &cobra.Command{
+	Use:   "passwd <name>",
+	Short: "Change a user's password",
+	Long: `Change the password of a user account. The new password is prompted for in
+a terminal, or read from stdin.
+
+Examples:
+  unraidcli users passwd alice
+  echo "$PASSWORD" | unraidcli users passwd alice`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		password, err := readPassword(fmt.Sprintf("New password for '%s'", name))
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := apiClient.SetUserPassword(ctx, name, password); err != nil {
+			return fmt.Errorf("failed to change password: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Password for '%s' changed\n", name)
+		} else {
+			formatter.Print(map[string]string{
+				"status":  "success",
+				"message": "Password changed successfully",
+				"user":    name,
+			})
+		}
+		return nil
+	},
+}
+
+// readPassword reads a password without echoing it when stdin is a terminal,
+// asking for it twice, or from the first line of stdin otherwise
+func readPassword(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+
+	if !term.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", fmt.Errorf("failed to read password from stdin: %w", err)
+		}
+
+		password := strings.TrimRight(line, "\r\n")
+		if password == "" {
+			return "", fmt.Errorf("password must not be empty")
+		}
+		return password, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: ", prompt)
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	if len(password) == 0 {
+		return "", fmt.Errorf("password must not be empty")
+	}
+
+	fmt.Fprintf(os.Stderr, "Retype password: ")
+	confirm, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	if string(confirm) != string(password) {
+		return "", fmt.Errorf("passwords do not match")
+	}
+
+	return string(password), nil
+}
+
+func init() {
+	rootCmd.AddCommand(usersCmd)
+	usersCmd.AddCommand(usersListCmd)
+	usersCmd.AddCommand(usersAddCmd)
+	usersCmd.AddCommand(usersDeleteCmd)
+	usersCmd.AddCommand(usersPasswdCmd)
+
+	usersAddCmd.Flags().StringVarP(&userDescription, "description", "d", "", "Account description")
+	usersDeleteCmd.Flags().BoolVarP(&userYes, "yes", "y", false, "Skip the confirmation prompt")
+}
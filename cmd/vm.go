@@ -3,12 +3,33 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/01dnot/unraidcli/internal/client"
 	"github.com/01dnot/unraidcli/internal/output"
 	"github.com/spf13/cobra"
 )
 
+// autoSnapshotName is the --before value used when no snapshot name is given
+const autoSnapshotName = "auto"
+
+// vmSnapshotTimeout bounds snapshot operations, which can take a while on large disks
+const vmSnapshotTimeout = 10 * time.Minute
+
+var (
+	vmRestartBefore       string
+	vmSnapshotDescription string
+	vmSnapshotWait        bool
+	vmConsoleProxy        string
+)
+
 // vmCmd represents the vm command
 var vmCmd = &cobra.Command{
 	Use:   "vm",
@@ -121,15 +142,44 @@ var vmStopCmd = &cobra.Command{
 var vmRestartCmd = &cobra.Command{
 	Use:   "restart <vm>",
 	Short: "Restart a VM",
-	Long:  "Restart a virtual machine by name or UUID.",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
+	Long: `Restart a virtual machine by name or UUID.
+
+Use --before to take a snapshot before restarting. Without a value the
+snapshot name is generated from the VM name and the current time.
 
+Examples:
+  unraidcli vm restart windows11
+  unraidcli vm restart windows11 --before
+  unraidcli vm restart windows11 --before=pre-update`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		vm := args[0]
+
+		if vmRestartBefore != "" {
+			name := vmRestartBefore
+			if name == autoSnapshotName {
+				name = defaultSnapshotName(vm)
+			}
+
+			snapCtx, snapCancel := context.WithTimeout(context.Background(), vmSnapshotTimeout)
+			defer snapCancel()
+
+			fmt.Printf("Creating snapshot '%s' of VM '%s'...\n", name, vm)
+			if _, err := apiClient.CreateVMSnapshot(snapCtx, vm, name, "Automatic snapshot before restart"); err != nil {
+				return fmt.Errorf("failed to create snapshot, VM not restarted: %w", err)
+			}
+			if err := waitForVMSnapshot(snapCtx, vm, name, true); err != nil {
+				return fmt.Errorf("snapshot did not complete, VM not restarted: %w", err)
+			}
+			fmt.Printf("✓ Snapshot '%s' created\n", name)
+		}
+
 		fmt.Printf("Restarting VM '%s'...\n", vm)
 
+		// Created after the snapshot, which runs on its own longer timeout
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
 		if err := apiClient.RestartVM(ctx, vm); err != nil {
 			return fmt.Errorf("failed to restart VM: %w", err)
 		}
@@ -148,10 +198,387 @@ var vmRestartCmd = &cobra.Command{
 	},
 }
 
+// vmSnapshotCmd represents the vm snapshot command
+var vmSnapshotCmd = &cobra.Command{
+	Use:     "snapshot",
+	Aliases: []string{"snap"},
+	Short:   "Manage VM snapshots",
+	Long:    "Create, list, revert, and delete snapshots of virtual machines.",
+}
+
+// vmSnapshotCreateCmd represents the vm snapshot create command
+var vmSnapshotCreateCmd = &cobra.Command{
+	Use:   "create <vm> [name]",
+	Short: "Create a VM snapshot",
+	Long: `Create a snapshot of a virtual machine. If no name is given, one is
+generated from the VM name and the current time.
+
+Examples:
+  unraidcli vm snapshot create windows11
+  unraidcli vm snapshot create windows11 pre-update --description "Before KB5034441" --wait`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), vmSnapshotTimeout)
+		defer cancel()
+
+		vm := args[0]
+		name := defaultSnapshotName(vm)
+		if len(args) > 1 {
+			name = args[1]
+		}
+
+		fmt.Printf("Creating snapshot '%s' of VM '%s'...\n", name, vm)
+
+		snapshot, err := apiClient.CreateVMSnapshot(ctx, vm, name, vmSnapshotDescription)
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot: %w", err)
+		}
+
+		if vmSnapshotWait {
+			if err := waitForVMSnapshot(ctx, vm, name, true); err != nil {
+				return err
+			}
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Snapshot '%s' of VM '%s' created successfully\n", name, vm)
+		} else {
+			formatter.Print(snapshot)
+		}
+
+		return nil
+	},
+}
+
+// vmSnapshotLsCmd represents the vm snapshot ls command
+var vmSnapshotLsCmd = &cobra.Command{
+	Use:     "ls <vm>",
+	Aliases: []string{"list"},
+	Short:   "List VM snapshots",
+	Long:    "List all snapshots of a virtual machine.",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		vm := args[0]
+
+		snapshots, err := apiClient.GetVMSnapshots(ctx, vm)
+		if err != nil {
+			return fmt.Errorf("failed to get snapshots: %w", err)
+		}
+
+		if len(snapshots) == 0 {
+			fmt.Printf("No snapshots found for VM '%s'.\n", vm)
+			return nil
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			headers := []string{"Name", "Created", "State", "Description"}
+			var rows [][]string
+
+			for _, snapshot := range snapshots {
+				rows = append(rows, []string{
+					snapshot.Name,
+					snapshot.CreatedAt,
+					output.FormatState(snapshot.State),
+					snapshot.Description,
+				})
+			}
+
+			formatter.PrintTable(headers, rows)
+		} else {
+			formatter.Print(snapshots)
+		}
+
+		return nil
+	},
+}
+
+// vmSnapshotRevertCmd represents the vm snapshot revert command
+var vmSnapshotRevertCmd = &cobra.Command{
+	Use:   "revert <vm> <name>",
+	Short: "Revert a VM to a snapshot",
+	Long:  "Revert a virtual machine to a previously taken snapshot.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), vmSnapshotTimeout)
+		defer cancel()
+
+		vm, name := args[0], args[1]
+		fmt.Printf("Reverting VM '%s' to snapshot '%s'...\n", vm, name)
+
+		if err := apiClient.RevertVMSnapshot(ctx, vm, name); err != nil {
+			return fmt.Errorf("failed to revert snapshot: %w", err)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ VM '%s' reverted to snapshot '%s'\n", vm, name)
+		} else {
+			formatter.Print(map[string]string{
+				"status":   "success",
+				"message":  "VM reverted successfully",
+				"vm":       vm,
+				"snapshot": name,
+			})
+		}
+
+		return nil
+	},
+}
+
+// vmSnapshotDeleteCmd represents the vm snapshot delete command
+var vmSnapshotDeleteCmd = &cobra.Command{
+	Use:     "delete <vm> <name>",
+	Aliases: []string{"rm"},
+	Short:   "Delete a VM snapshot",
+	Long:    "Delete a snapshot of a virtual machine.",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), vmSnapshotTimeout)
+		defer cancel()
+
+		vm, name := args[0], args[1]
+		fmt.Printf("Deleting snapshot '%s' of VM '%s'...\n", name, vm)
+
+		if err := apiClient.DeleteVMSnapshot(ctx, vm, name); err != nil {
+			return fmt.Errorf("failed to delete snapshot: %w", err)
+		}
+
+		if vmSnapshotWait {
+			if err := waitForVMSnapshot(ctx, vm, name, false); err != nil {
+				return err
+			}
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("✓ Snapshot '%s' of VM '%s' deleted successfully\n", name, vm)
+		} else {
+			formatter.Print(map[string]string{
+				"status":   "success",
+				"message":  "Snapshot deleted successfully",
+				"vm":       vm,
+				"snapshot": name,
+			})
+		}
+
+		return nil
+	},
+}
+
+// vmConsoleCmd represents the vm console command
+var vmConsoleCmd = &cobra.Command{
+	Use:   "console <vm>",
+	Short: "Connect to a VM console",
+	Long: `Show connection details for a VM's VNC console, or run a local proxy so
+desktop VNC viewers can connect through the server's websocket proxy using
+the stored API credentials.
+
+The proxy does not require authentication, so an address without a host
+(e.g. :5900) listens on 127.0.0.1 only. Give an explicit address such as
+0.0.0.0:5900 to accept connections from other machines.
+
+Examples:
+  unraidcli vm console windows11
+  unraidcli vm console windows11 --proxy :5900
+  unraidcli vm console windows11 --proxy 192.168.1.20:5901`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		vm := args[0]
+
+		console, err := apiClient.GetVMConsole(ctx, vm)
+		if err != nil {
+			return fmt.Errorf("failed to get VM console: %w", err)
+		}
+
+		if vmConsoleProxy != "" {
+			return runConsoleProxy(vm, console)
+		}
+
+		uri := fmt.Sprintf("vnc://%s", net.JoinHostPort(apiClient.Host(), strconv.Itoa(console.Port)))
+		wsURL := ""
+		if console.WebsocketPort > 0 {
+			wsURL = apiClient.ConsoleWebsocketURL(console.WebsocketPort)
+		}
+
+		if outputFormat == "" || outputFormat == "table" {
+			fmt.Printf("VM: %s\n", vm)
+			fmt.Printf("Console: %s\n", strings.ToUpper(console.Type))
+			fmt.Printf("URI: %s\n", uri)
+			if wsURL != "" {
+				fmt.Printf("WebSocket: %s\n", wsURL)
+			}
+			fmt.Printf("\nIf the VNC port is not reachable, use --proxy :5900 and connect to localhost.\n")
+		} else {
+			formatter.Print(map[string]interface{}{
+				"vm":            vm,
+				"type":          console.Type,
+				"port":          console.Port,
+				"websocketPort": console.WebsocketPort,
+				"uri":           uri,
+				"websocketUrl":  wsURL,
+			})
+		}
+
+		return nil
+	},
+}
+
+// proxyListenAddress returns the address to listen on for --proxy. The proxy
+// is unauthenticated, so an address without a host binds to localhost only.
+func proxyListenAddress(value string) (string, error) {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid proxy address %q (use [host]:port, e.g. :5900)", value)
+	}
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// runConsoleProxy listens on the --proxy address and forwards each TCP
+// connection to the VM console over the server's websocket proxy
+func runConsoleProxy(vm string, console *client.VMConsole) error {
+	if console.WebsocketPort <= 0 {
+		return fmt.Errorf("VM '%s' has no websocket console port", vm)
+	}
+
+	address, err := proxyListenAddress(vmConsoleProxy)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+	defer listener.Close()
+
+	// Setup signal handling for graceful exit
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		cancel()
+		listener.Close()
+	}()
+
+	fmt.Printf("Proxying console of VM '%s' on %s\n", vm, listener.Addr())
+	fmt.Println("Point your VNC viewer at this address. Press Ctrl+C to stop.")
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		go func() {
+			defer conn.Close()
+			fmt.Printf("Client connected from %s\n", conn.RemoteAddr())
+
+			remote, err := apiClient.DialVMConsole(ctx, console.WebsocketPort)
+			if err != nil {
+				fmt.Printf("%s\n", output.Error(err.Error()))
+				return
+			}
+			defer remote.Close()
+
+			done := make(chan struct{}, 2)
+			go func() {
+				io.Copy(remote, conn)
+				done <- struct{}{}
+			}()
+			go func() {
+				io.Copy(conn, remote)
+				done <- struct{}{}
+			}()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+			fmt.Printf("Client %s disconnected\n", conn.RemoteAddr())
+		}()
+	}
+}
+
+// defaultSnapshotName generates a snapshot name from the VM name and current time
+func defaultSnapshotName(vm string) string {
+	return fmt.Sprintf("%s-%s", vm, time.Now().Format("20060102-150405"))
+}
+
+// waitForVMSnapshot polls the snapshot list until the named snapshot is
+// present and no longer in progress (or gone, if present is false)
+func waitForVMSnapshot(ctx context.Context, vm string, name string, present bool) error {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		snapshots, err := apiClient.GetVMSnapshots(ctx, vm)
+		if err != nil {
+			return fmt.Errorf("failed to get snapshots: %w", err)
+		}
+
+		var found *client.VMSnapshot
+		for i, snapshot := range snapshots {
+			if snapshot.Name == name {
+				found = &snapshots[i]
+				break
+			}
+		}
+
+		if present && found != nil {
+			if found.Failed() {
+				return fmt.Errorf("snapshot '%s' failed (state %s)", name, found.State)
+			}
+			if !found.InProgress() {
+				return nil
+			}
+		} else if !present && found == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for snapshot '%s'", name)
+		case <-ticker.C:
+		}
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(vmCmd)
 	vmCmd.AddCommand(vmLsCmd)
 	vmCmd.AddCommand(vmStartCmd)
 	vmCmd.AddCommand(vmStopCmd)
 	vmCmd.AddCommand(vmRestartCmd)
+	vmCmd.AddCommand(vmSnapshotCmd)
+	vmCmd.AddCommand(vmConsoleCmd)
+
+	vmSnapshotCmd.AddCommand(vmSnapshotCreateCmd)
+	vmSnapshotCmd.AddCommand(vmSnapshotLsCmd)
+	vmSnapshotCmd.AddCommand(vmSnapshotRevertCmd)
+	vmSnapshotCmd.AddCommand(vmSnapshotDeleteCmd)
+
+	// Add flags for vm restart
+	vmRestartCmd.Flags().StringVar(&vmRestartBefore, "before", "", "Take a snapshot before restarting (optionally --before=<name>)")
+	vmRestartCmd.Flags().Lookup("before").NoOptDefVal = autoSnapshotName
+
+	// Add flags for vm console
+	vmConsoleCmd.Flags().StringVar(&vmConsoleProxy, "proxy", "", "Run a local VNC proxy on this address (e.g. :5900, localhost unless a host is given)")
+
+	// Add flags for vm snapshot create and delete
+	vmSnapshotCreateCmd.Flags().StringVarP(&vmSnapshotDescription, "description", "d", "", "Snapshot description")
+	vmSnapshotCreateCmd.Flags().BoolVar(&vmSnapshotWait, "wait", false, "Wait until the snapshot has been created")
+	vmSnapshotDeleteCmd.Flags().BoolVar(&vmSnapshotWait, "wait", false, "Wait until the snapshot has been deleted")
 }
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// Host returns the host name of the Unraid server, without port
+func (c *Client) Host() string {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// ConsoleWebsocketURL returns the URL of the server's websocket proxy for a
+// VM console listening on the given websocket port
+func (c *Client) ConsoleWebsocketURL(websocketPort int) string {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return ""
+	}
+
+	if u.Scheme == "https" {
+		u.Scheme = "wss"
+	} else {
+		u.Scheme = "ws"
+	}
+	u.Path = fmt.Sprintf("/wsproxy/%d/", websocketPort)
+	u.RawQuery = ""
+
+	return u.String()
+}
+
+// DialVMConsole opens a websocket connection to a VM console through the
+// server's websocket proxy, authenticated with the client's API key. The
+// returned stream carries raw VNC (RFB) traffic.
+func (c *Client) DialVMConsole(ctx context.Context, websocketPort int) (io.ReadWriteCloser, error) {
+	header := http.Header{}
+	header.Set("x-api-key", c.apiKey)
+
+	dialer := websocket.Dialer{
+		Proxy:        http.ProxyFromEnvironment,
+		Subprotocols: []string{"binary"},
+	}
+
+	conn, resp, err := dialer.DialContext(ctx, c.ConsoleWebsocketURL(websocketPort), header)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("console connection failed: %s", resp.Status)
+		}
+		return nil, fmt.Errorf("console connection failed: %w", err)
+	}
+
+	return &consoleConn{conn: conn}, nil
+}
+
+// consoleConn adapts a websocket connection to a byte stream
+type consoleConn struct {
+	conn    *websocket.Conn
+	reader  io.Reader
+	writeMu sync.Mutex
+}
+
+// Read reads from the current websocket message, moving on to the next
+// message when the current one is exhausted
+func (c *consoleConn) Read(p []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			_, reader, err := c.conn.NextReader()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					return 0, io.EOF
+				}
+				return 0, err
+			}
+			c.reader = reader
+		}
+
+		n, err := c.reader.Read(p)
+		if err == io.EOF {
+			c.reader = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+// Write sends p as a single binary websocket message
+func (c *consoleConn) Write(p []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close closes the websocket connection
+func (c *consoleConn) Close() error {
+	c.writeMu.Lock()
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	c.conn.WriteMessage(websocket.CloseMessage, msg)
+	c.writeMu.Unlock()
+
+	if err := c.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -190,14 +191,164 @@ func (c *Client) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
 	return &response.Info, nil
 }
 
+// NetworkInterface represents a network interface, bond, bridge, or VLAN
+type NetworkInterface struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	State   string   `json:"state"`
+	MAC     string   `json:"mac"`
+	MTU     int      `json:"mtu"`
+	Speed   int      `json:"speed"`
+	IPv4    []string `json:"ipv4"`
+	IPv6    []string `json:"ipv6"`
+	Master  string   `json:"master"`
+	Members []string `json:"members"`
+	VLANID  int      `json:"vlanId"`
+	RxBytes int64    `json:"rxBytes"`
+	TxBytes int64    `json:"txBytes"`
+}
+
+// GetNetworkInterfaces retrieves the network interfaces with their addresses
+// and byte counters. Speed is in Mbps.
+func (c *Client) GetNetworkInterfaces(ctx context.Context) ([]NetworkInterface, error) {
+	query := `
+		query {
+			info {
+				networkInterfaces {
+					name
+					type
+					state
+					mac
+					mtu
+					speed
+					ipv4
+					ipv6
+					master
+					members
+					vlanId
+					rxBytes
+					txBytes
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Info struct {
+			NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+		} `json:"info"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Info.NetworkInterfaces, nil
+}
+
+// UPSDevice represents a UPS monitored by the server
+type UPSDevice struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Model   string `json:"model"`
+	Status  string `json:"status"`
+	Battery struct {
+		ChargeLevel      int    `json:"chargeLevel"`
+		EstimatedRuntime int    `json:"estimatedRuntime"`
+		Health           string `json:"health"`
+	} `json:"battery"`
+	Power struct {
+		InputVoltage   float64 `json:"inputVoltage"`
+		OutputVoltage  float64 `json:"outputVoltage"`
+		LoadPercentage float64 `json:"loadPercentage"`
+	} `json:"power"`
+}
+
+// OnBattery reports whether the UPS is running on battery power
+func (u UPSDevice) OnBattery() bool {
+	status := strings.NewReplacer(" ", "", "_", "").Replace(strings.ToUpper(u.Status))
+	return strings.Contains(status, "ONBATT")
+}
+
+// OnLine reports whether the UPS is on line power with no other condition
+// (low battery, overload, lost communication, ...) reported
+func (u UPSDevice) OnLine() bool {
+	status := strings.NewReplacer(" ", "", "_", "").Replace(strings.ToUpper(u.Status))
+	return status == "ONLINE" || status == "OL"
+}
+
+// GetUPSDevices retrieves the UPS devices. EstimatedRuntime is in seconds.
+// API versions without UPS support are treated as having no UPS.
+func (c *Client) GetUPSDevices(ctx context.Context) ([]UPSDevice, error) {
+	query := `
+		query {
+			upsDevices {
+				id
+				name
+				model
+				status
+				battery {
+					chargeLevel
+					estimatedRuntime
+					health
+				}
+				power {
+					inputVoltage
+					outputVoltage
+					loadPercentage
+				}
+			}
+		}
+	`
+
+	var response struct {
+		UPSDevices []UPSDevice `json:"upsDevices"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		if isUnsupportedField(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return response.UPSDevices, nil
+}
+
+// Kilobytes is a size in kilobytes. The API reports some sizes as numeric
+// strings, so it unmarshals from either a JSON string or number.
+type Kilobytes int64
+
+// UnmarshalJSON parses a kilobyte value from a JSON string or number
+func (k *Kilobytes) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+	if text == "" || text == "null" {
+		*k = 0
+		return nil
+	}
+
+	value, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid kilobyte value %s: %w", data, err)
+	}
+
+	*k = Kilobytes(value)
+	return nil
+}
+
+// Bytes returns the size in bytes
+func (k Kilobytes) Bytes() int64 {
+	return int64(k) * 1024
+}
+
 // ArrayInfo contains array information
 type ArrayInfo struct {
 	State    string `json:"state"`
 	Capacity struct {
 		Kilobytes struct {
-			Total string `json:"total"`
-			Used  string `json:"used"`
-			Free  string `json:"free"`
+			Total Kilobytes `json:"total"`
+			Used  Kilobytes `json:"used"`
+			Free  Kilobytes `json:"free"`
 		} `json:"kilobytes"`
 	} `json:"capacity"`
 	Boot     *ArrayDisk   `json:"boot"`
@@ -227,18 +378,70 @@ func (a *ArrayInfo) AllDisks() []ArrayDisk {
 	return allDisks
 }
 
+// FindDisk returns the array disk matching the given ID, name, or device
+func (a *ArrayInfo) FindDisk(ref string) *ArrayDisk {
+	allDisks := a.AllDisks()
+	device := strings.TrimPrefix(ref, "/dev/")
+
+	for i, disk := range allDisks {
+		if disk.ID == ref || disk.Name == ref || (disk.Device != "" && disk.Device == device) {
+			return &allDisks[i]
+		}
+	}
+
+	return nil
+}
+
 // ArrayDisk represents a disk in the array
 type ArrayDisk struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Device      string  `json:"device"`
-	Status      string  `json:"status"`
-	Size        int64   `json:"size"`
-	Temperature int     `json:"temp"`
-	Type        string  `json:"type"`
-	FsType      string  `json:"fsType"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Device      string    `json:"device"`
+	Status      string    `json:"status"`
+	Size        int64     `json:"size"`
+	Temperature int       `json:"temp"`
+	Type        string    `json:"type"`
+	FsType      string    `json:"fsType"`
+	Rotational  bool      `json:"rotational"`
+	IsSpinning  bool      `json:"isSpinning"`
+	FsSize      Kilobytes `json:"fsSize"`
+	FsUsed      Kilobytes `json:"fsUsed"`
+	FsFree      Kilobytes `json:"fsFree"`
+	NumReads    int64     `json:"numReads"`
+	NumWrites   int64     `json:"numWrites"`
+	NumErrors   int64     `json:"numErrors"`
+}
+
+// UsedPercent returns the percentage of the disk's filesystem in use
+func (d *ArrayDisk) UsedPercent() float64 {
+	if d.FsSize <= 0 {
+		return 0
+	}
+	return float64(d.FsUsed) / float64(d.FsSize) * 100
 }
 
+// arrayDiskFragment selects the fields of an ArrayDisk
+const arrayDiskFragment = `
+	fragment ArrayDiskFields on ArrayDisk {
+		id
+		name
+		device
+		status
+		size
+		temp
+		type
+		fsType
+		rotational
+		isSpinning
+		fsSize
+		fsUsed
+		fsFree
+		numReads
+		numWrites
+		numErrors
+	}
+`
+
 // GetArrayInfo retrieves array information
 func (c *Client) GetArrayInfo(ctx context.Context) (*ArrayInfo, error) {
 	query := `
@@ -253,48 +456,20 @@ func (c *Client) GetArrayInfo(ctx context.Context) (*ArrayInfo, error) {
 					}
 				}
 				boot {
-					id
-					name
-					device
-					status
-					size
-					temp
-					type
-					fsType
+					...ArrayDiskFields
 				}
 				parities {
-					id
-					name
-					device
-					status
-					size
-					temp
-					type
-					fsType
+					...ArrayDiskFields
 				}
 				disks {
-					id
-					name
-					device
-					status
-					size
-					temp
-					type
-					fsType
+					...ArrayDiskFields
 				}
 				caches {
-					id
-					name
-					device
-					status
-					size
-					temp
-					type
-					fsType
+					...ArrayDiskFields
 				}
 			}
 		}
-	`
+	` + arrayDiskFragment
 
 	var response struct {
 		Array ArrayInfo `json:"array"`
@@ -307,6 +482,204 @@ func (c *Client) GetArrayInfo(ctx context.Context) (*ArrayInfo, error) {
 	return &response.Array, nil
 }
 
+// SpinUpDisk spins up an array disk
+func (c *Client) SpinUpDisk(ctx context.Context, id string) error {
+	mutation := `
+		mutation($id: PrefixedID!) {
+			array {
+				spinUpDisk(id: $id) {
+					id
+					isSpinning
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Array struct {
+			SpinUpDisk struct {
+				ID         string `json:"id"`
+				IsSpinning bool   `json:"isSpinning"`
+			} `json:"spinUpDisk"`
+		} `json:"array"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SpinDownDisk spins down an array disk
+func (c *Client) SpinDownDisk(ctx context.Context, id string) error {
+	mutation := `
+		mutation($id: PrefixedID!) {
+			array {
+				spinDownDisk(id: $id) {
+					id
+					isSpinning
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Array struct {
+			SpinDownDisk struct {
+				ID         string `json:"id"`
+				IsSpinning bool   `json:"isSpinning"`
+			} `json:"spinDownDisk"`
+		} `json:"array"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SmartAttribute represents a single SMART attribute of a disk
+type SmartAttribute struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Value      int    `json:"value"`
+	Worst      int    `json:"worst"`
+	Threshold  int    `json:"threshold"`
+	RawValue   string `json:"rawValue"`
+	WhenFailed string `json:"whenFailed"`
+}
+
+// Raw returns the numeric part of the attribute's raw value
+func (a SmartAttribute) Raw() int64 {
+	fields := strings.Fields(a.RawValue)
+	if len(fields) == 0 {
+		return 0
+	}
+	raw, _ := strconv.ParseInt(fields[0], 10, 64)
+	return raw
+}
+
+// Common SMART attribute IDs
+const (
+	SmartReallocatedSectors = 5
+	SmartPowerOnHours       = 9
+	SmartReportedUncorrect  = 187
+	SmartPendingSectors     = 197
+	SmartOfflineUncorrect   = 198
+	SmartCRCErrors          = 199
+)
+
+// Disk represents a physical disk attached to the server
+type Disk struct {
+	ID              string           `json:"id"`
+	Device          string           `json:"device"`
+	Name            string           `json:"name"`
+	Vendor          string           `json:"vendor"`
+	SerialNum       string           `json:"serialNum"`
+	Size            int64            `json:"size"`
+	Type            string           `json:"type"`
+	InterfaceType   string           `json:"interfaceType"`
+	SmartStatus     string           `json:"smartStatus"`
+	Temperature     float64          `json:"temperature"`
+	IsSpinning      bool             `json:"isSpinning"`
+	SmartAttributes []SmartAttribute `json:"smartAttributes,omitempty"`
+}
+
+// SmartAttribute returns the SMART attribute with the given ID, or nil
+func (d *Disk) SmartAttribute(id int) *SmartAttribute {
+	for i, attr := range d.SmartAttributes {
+		if attr.ID == id {
+			return &d.SmartAttributes[i]
+		}
+	}
+	return nil
+}
+
+// GetDisks retrieves all physical disks
+func (c *Client) GetDisks(ctx context.Context) ([]Disk, error) {
+	query := `
+		query {
+			disks {
+				id
+				device
+				name
+				vendor
+				serialNum
+				size
+				type
+				interfaceType
+				smartStatus
+				temperature
+				isSpinning
+			}
+		}
+	`
+
+	var response struct {
+		Disks []Disk `json:"disks"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Disks, nil
+}
+
+// GetDisk retrieves a physical disk including its SMART attributes
+func (c *Client) GetDisk(ctx context.Context, id string) (*Disk, error) {
+	query := `
+		query($id: PrefixedID!) {
+			disk(id: $id) {
+				id
+				device
+				name
+				vendor
+				serialNum
+				size
+				type
+				interfaceType
+				smartStatus
+				temperature
+				isSpinning
+				smartAttributes {
+					id
+					name
+					value
+					worst
+					threshold
+					rawValue
+					whenFailed
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Disk Disk `json:"disk"`
+	}
+
+	if err := c.Query(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Disk, nil
+}
+
 // StartArray starts the Unraid array
 func (c *Client) StartArray(ctx context.Context) error {
 	mutation := `
@@ -361,6 +734,52 @@ func (c *Client) StopArray(ctx context.Context) error {
 	return nil
 }
 
+// RebootServer reboots the Unraid server
+func (c *Client) RebootServer(ctx context.Context) error {
+	mutation := `
+		mutation {
+			server {
+				reboot
+			}
+		}
+	`
+
+	var response struct {
+		Server struct {
+			Reboot bool `json:"reboot"`
+		} `json:"server"`
+	}
+
+	if err := c.Mutate(ctx, mutation, nil, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ShutdownServer powers off the Unraid server
+func (c *Client) ShutdownServer(ctx context.Context) error {
+	mutation := `
+		mutation {
+			server {
+				shutdown
+			}
+		}
+	`
+
+	var response struct {
+		Server struct {
+			Shutdown bool `json:"shutdown"`
+		} `json:"server"`
+	}
+
+	if err := c.Mutate(ctx, mutation, nil, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Container represents a Docker container
 type Container struct {
 	ID        string   `json:"id"`
@@ -696,39 +1115,584 @@ func (c *Client) RestartVM(ctx context.Context, nameOrID string) error {
 	return nil
 }
 
-// Share represents a user share
-type Share struct {
-	ID      string   `json:"id"`
-	Name    string   `json:"name"`
-	Free    int64    `json:"free"`
-	Used    int64    `json:"used"`
-	Size    int64    `json:"size"`
-	Include []string `json:"include"`
-	Exclude []string `json:"exclude"`
-	Cache   bool     `json:"cache"`
-	Comment string   `json:"comment"`
+// VMConsole contains the connection details of a VM's graphical console
+type VMConsole struct {
+	Type          string `json:"type"`
+	Port          int    `json:"port"`
+	WebsocketPort int    `json:"websocketPort"`
 }
 
-// GetShares retrieves all user shares
-func (c *Client) GetShares(ctx context.Context) ([]Share, error) {
+// GetVMConsole retrieves the console connection details of a virtual machine
+func (c *Client) GetVMConsole(ctx context.Context, nameOrID string) (*VMConsole, error) {
+	// Find the VM ID
+	id, err := c.FindVMID(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 		query {
-			shares {
-				id
-				name
-				free
-				used
-				size
-				include
-				exclude
-				cache
-				comment
-			}
-		}
-	`
+			vms {
+				domains {
+					id
+					console {
+						type
+						port
+						websocketPort
+					}
+				}
+			}
+		}
+	`
+
+	var response struct {
+		VMs struct {
+			Domains []struct {
+				ID      string     `json:"id"`
+				Console *VMConsole `json:"console"`
+			} `json:"domains"`
+		} `json:"vms"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	for _, domain := range response.VMs.Domains {
+		if domain.ID != id {
+			continue
+		}
+		if domain.Console == nil || domain.Console.Port <= 0 {
+			return nil, fmt.Errorf("VM has no console available (is it running?): %s", nameOrID)
+		}
+		return domain.Console, nil
+	}
+
+	return nil, fmt.Errorf("VM not found: %s", nameOrID)
+}
+
+// VMSnapshot represents a snapshot of a virtual machine
+type VMSnapshot struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"createdAt"`
+	State       string `json:"state"`
+}
+
+// InProgress reports whether the snapshot is still being created or deleted
+func (s VMSnapshot) InProgress() bool {
+	switch strings.ToUpper(s.State) {
+	case "CREATING", "PENDING", "IN_PROGRESS", "DELETING":
+		return true
+	}
+	return false
+}
+
+// Failed reports whether creating the snapshot failed
+func (s VMSnapshot) Failed() bool {
+	switch strings.ToUpper(s.State) {
+	case "FAILED", "ERROR":
+		return true
+	}
+	return false
+}
+
+// GetVMSnapshots retrieves all snapshots of a virtual machine
+func (c *Client) GetVMSnapshots(ctx context.Context, nameOrID string) ([]VMSnapshot, error) {
+	// Find the VM ID
+	id, err := c.FindVMID(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		query {
+			vms {
+				domains {
+					id
+					snapshots {
+						name
+						description
+						createdAt
+						state
+					}
+				}
+			}
+		}
+	`
+
+	var response struct {
+		VMs struct {
+			Domains []struct {
+				ID        string       `json:"id"`
+				Snapshots []VMSnapshot `json:"snapshots"`
+			} `json:"domains"`
+		} `json:"vms"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	for _, domain := range response.VMs.Domains {
+		if domain.ID == id {
+			return domain.Snapshots, nil
+		}
+	}
+
+	return nil, fmt.Errorf("VM not found: %s", nameOrID)
+}
+
+// CreateVMSnapshot creates a snapshot of a virtual machine
+func (c *Client) CreateVMSnapshot(ctx context.Context, nameOrID string, name string, description string) (*VMSnapshot, error) {
+	// Find the VM ID
+	id, err := c.FindVMID(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	mutation := `
+		mutation($id: PrefixedID!, $name: String!, $description: String) {
+			vm {
+				createSnapshot(id: $id, input: {name: $name, description: $description}) {
+					name
+					description
+					createdAt
+					state
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":   id,
+		"name": name,
+	}
+
+	if description != "" {
+		variables["description"] = description
+	}
+
+	var response struct {
+		VM struct {
+			CreateSnapshot VMSnapshot `json:"createSnapshot"`
+		} `json:"vm"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.VM.CreateSnapshot, nil
+}
+
+// RevertVMSnapshot reverts a virtual machine to a snapshot
+func (c *Client) RevertVMSnapshot(ctx context.Context, nameOrID string, name string) error {
+	// Find the VM ID
+	id, err := c.FindVMID(ctx, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	mutation := `
+		mutation($id: PrefixedID!, $name: String!) {
+			vm {
+				revertSnapshot(id: $id, name: $name)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":   id,
+		"name": name,
+	}
+
+	var response struct {
+		VM struct {
+			RevertSnapshot bool `json:"revertSnapshot"`
+		} `json:"vm"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteVMSnapshot deletes a snapshot of a virtual machine
+func (c *Client) DeleteVMSnapshot(ctx context.Context, nameOrID string, name string) error {
+	// Find the VM ID
+	id, err := c.FindVMID(ctx, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	mutation := `
+		mutation($id: PrefixedID!, $name: String!) {
+			vm {
+				deleteSnapshot(id: $id, name: $name)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":   id,
+		"name": name,
+	}
+
+	var response struct {
+		VM struct {
+			DeleteSnapshot bool `json:"deleteSnapshot"`
+		} `json:"vm"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Share represents a user share
+type Share struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Free       int64       `json:"free"`
+	Used       int64       `json:"used"`
+	Size       int64       `json:"size"`
+	Include    []string    `json:"include"`
+	Exclude    []string    `json:"exclude"`
+	Cache      bool        `json:"cache"`
+	Comment    string      `json:"comment"`
+	Allocator  string      `json:"allocator"`
+	SplitLevel string      `json:"splitLevel"`
+	Floor      Kilobytes   `json:"floor"`
+	UseCache   string      `json:"useCache"`
+	CachePool  string      `json:"cachePool"`
+	SMB        ShareExport `json:"smb"`
+	NFS        ShareExport `json:"nfs"`
+	CacheUsed  int64       `json:"cacheUsed"`
+}
+
+// ShareExport describes how a share is exported over SMB or NFS. Export is
+// "yes", "no" or (SMB only) "hidden"; Security is "public", "secure" or
+// "private".
+type ShareExport struct {
+	Export   string            `json:"export"`
+	Security string            `json:"security"`
+	Users    []ShareUserAccess `json:"users,omitempty" yaml:"users,omitempty"`
+	Rule     string            `json:"rule,omitempty" yaml:"rule,omitempty"`
+}
+
+// ShareUserAccess is a user's access to a share exported with secure or
+// private security. Access is "read-write", "read-only" or "no-access".
+type ShareUserAccess struct {
+	User   string `json:"user" yaml:"user"`
+	Access string `json:"access" yaml:"access"`
+}
+
+// ShareExports holds how a share is exported over SMB and NFS. SMB.Users
+// lists per-user access; NFS.Rule holds the NFS host rule.
+type ShareExports struct {
+	Name string      `json:"name" yaml:"name"`
+	SMB  ShareExport `json:"smb" yaml:"smb"`
+	NFS  ShareExport `json:"nfs" yaml:"nfs"`
+}
+
+// ShareInput holds the settings for creating or updating a share. Nil fields
+// are left unchanged on update and use the server default on create.
+type ShareInput struct {
+	Name        string     `json:"name,omitempty"`
+	Comment     *string    `json:"comment,omitempty"`
+	Allocator   *string    `json:"allocator,omitempty"`
+	SplitLevel  *string    `json:"splitLevel,omitempty"`
+	Floor       *Kilobytes `json:"floor,omitempty"`
+	Include     *[]string  `json:"include,omitempty"`
+	Exclude     *[]string  `json:"exclude,omitempty"`
+	UseCache    *string    `json:"useCache,omitempty"`
+	CachePool   *string    `json:"cachePool,omitempty"`
+	SMBExport   *string    `json:"smbExport,omitempty"`
+	SMBSecurity *string    `json:"smbSecurity,omitempty"`
+	NFSExport   *string    `json:"nfsExport,omitempty"`
+	NFSSecurity *string    `json:"nfsSecurity,omitempty"`
+}
+
+// shareFragment selects the fields of a Share, including the settings and
+// cache usage that only newer API versions support
+const shareFragment = `
+	fragment ShareFields on Share {
+		id
+		name
+		free
+		used
+		size
+		include
+		exclude
+		cache
+		comment
+		allocator
+		splitLevel
+		floor
+		useCache
+		cachePool
+		cacheUsed
+		smb {
+			export
+			security
+		}
+		nfs {
+			export
+			security
+		}
+	}
+`
+
+// GetShares retrieves all user shares
+func (c *Client) GetShares(ctx context.Context) ([]Share, error) {
+	query := `
+		query {
+			shares {
+				id
+				name
+				free
+				used
+				size
+				include
+				exclude
+				cache
+				comment
+			}
+		}
+	`
+
+	var response struct {
+		Shares []Share `json:"shares"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Shares, nil
+}
+
+// GetShareSettings retrieves all user shares with their allocation, cache,
+// and export settings and cache usage. API versions without these fields
+// reject the query; GetShares works with every version.
+func (c *Client) GetShareSettings(ctx context.Context) ([]Share, error) {
+	query := `
+		query {
+			shares {
+				...ShareFields
+			}
+		}
+	` + shareFragment
+
+	var response struct {
+		Shares []Share `json:"shares"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Shares, nil
+}
+
+// GetShare retrieves a user share with its settings by name
+func (c *Client) GetShare(ctx context.Context, name string) (*Share, error) {
+	shares, err := c.GetShareSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, share := range shares {
+		if share.Name == name {
+			return &shares[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("share '%s' not found", name)
+}
+
+// CreateShare creates a user share
+func (c *Client) CreateShare(ctx context.Context, input ShareInput) (*Share, error) {
+	mutation := `
+		mutation($input: ShareInput!) {
+			share {
+				create(input: $input) {
+					...ShareFields
+				}
+			}
+		}
+	` + shareFragment
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		Share struct {
+			Create Share `json:"create"`
+		} `json:"share"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Share.Create, nil
+}
+
+// UpdateShare changes the settings of a user share. Renaming is done by
+// setting input.Name to the new name.
+func (c *Client) UpdateShare(ctx context.Context, name string, input ShareInput) (*Share, error) {
+	mutation := `
+		mutation($name: String!, $input: ShareInput!) {
+			share {
+				update(name: $name, input: $input) {
+					...ShareFields
+				}
+			}
+		}
+	` + shareFragment
+
+	variables := map[string]interface{}{
+		"name":  name,
+		"input": input,
+	}
+
+	var response struct {
+		Share struct {
+			Update Share `json:"update"`
+		} `json:"share"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Share.Update, nil
+}
+
+// DeleteShare deletes a user share. The server refuses to delete shares
+// that still contain data.
+func (c *Client) DeleteShare(ctx context.Context, name string) error {
+	mutation := `
+		mutation($name: String!) {
+			share {
+				delete(name: $name)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"name": name,
+	}
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetShareExports retrieves the SMB and NFS export settings of all shares
+func (c *Client) GetShareExports(ctx context.Context) ([]ShareExports, error) {
+	query := `
+		query {
+			shares {
+				name
+				smb {
+					export
+					security
+					users {
+						user
+						access
+					}
+				}
+				nfs {
+					export
+					security
+					rule
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Shares []ShareExports `json:"shares"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Shares, nil
+}
+
+// SetShareUserAccess sets a user's access to a share to "read-write",
+// "read-only" or "no-access"
+func (c *Client) SetShareUserAccess(ctx context.Context, share string, user string, access string) error {
+	mutation := `
+		mutation($name: String!, $user: String!, $access: ShareAccess!) {
+			share {
+				setUserAccess(name: $name, user: $user, access: $access)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"name":   share,
+		"user":   user,
+		"access": access,
+	}
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ShareDiskUsage is the amount of a share's data stored on one array disk or pool
+type ShareDiskUsage struct {
+	Disk string `json:"disk" yaml:"disk"`
+	Used int64  `json:"used" yaml:"used"`
+}
+
+// ShareDistribution describes where a share's data actually lives
+type ShareDistribution struct {
+	Name    string           `json:"name" yaml:"name"`
+	Include []string         `json:"include" yaml:"include"`
+	Exclude []string         `json:"exclude" yaml:"exclude"`
+	Disks   []ShareDiskUsage `json:"distribution" yaml:"distribution"`
+}
+
+// GetShareDistribution retrieves how many bytes each share occupies on each
+// array disk and pool
+func (c *Client) GetShareDistribution(ctx context.Context) ([]ShareDistribution, error) {
+	query := `
+		query {
+			shares {
+				name
+				include
+				exclude
+				distribution {
+					disk
+					used
+				}
+			}
+		}
+	`
 
 	var response struct {
-		Shares []Share `json:"shares"`
+		Shares []ShareDistribution `json:"shares"`
 	}
 
 	if err := c.Query(ctx, query, nil, &response); err != nil {
@@ -738,6 +1702,82 @@ func (c *Client) GetShares(ctx context.Context) ([]Share, error) {
 	return response.Shares, nil
 }
 
+// MoverStatus is the state of the mover, which moves share data between
+// cache pools and the array
+type MoverStatus struct {
+	Running    bool   `json:"running" yaml:"running"`
+	StartedAt  string `json:"startedAt" yaml:"startedAt"`
+	FinishedAt string `json:"finishedAt" yaml:"finishedAt"`
+	Schedule   string `json:"schedule" yaml:"schedule"`
+}
+
+// GetMoverStatus retrieves the mover status and schedule
+func (c *Client) GetMoverStatus(ctx context.Context) (*MoverStatus, error) {
+	query := `
+		query {
+			mover {
+				running
+				startedAt
+				finishedAt
+				schedule
+			}
+		}
+	`
+
+	var response struct {
+		Mover MoverStatus `json:"mover"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Mover, nil
+}
+
+// StartMover starts the mover
+func (c *Client) StartMover(ctx context.Context) error {
+	mutation := `
+		mutation {
+			mover {
+				start
+			}
+		}
+	`
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, nil, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetMoverSchedule sets the mover schedule as a cron expression. An empty
+// schedule disables scheduled runs.
+func (c *Client) SetMoverSchedule(ctx context.Context, schedule string) error {
+	mutation := `
+		mutation($schedule: String!) {
+			mover {
+				setSchedule(schedule: $schedule)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"schedule": schedule,
+	}
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Metrics contains system metrics
 type Metrics struct {
 	CPU struct {
@@ -815,6 +1855,62 @@ type ParityCheck struct {
 	Running   bool   `json:"running"`
 }
 
+// parityDateLayouts are the date formats the API uses for parity checks
+var parityDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006 Jan _2 15:04:05",
+	"Mon Jan _2 15:04:05 2006",
+	"Mon, 02 Jan 2006 15:04:05",
+}
+
+// Time parses the date of the parity check
+func (p ParityCheck) Time() (time.Time, error) {
+	date := strings.TrimSpace(p.Date)
+	for _, layout := range parityDateLayouts {
+		if t, err := time.ParseInLocation(layout, date, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized parity check date: %q", p.Date)
+}
+
+// SpeedBytesPerSecond parses the reported speed (e.g. "145.2 MB/s") into
+// bytes per second. A bare number is taken to be bytes per second.
+func (p ParityCheck) SpeedBytesPerSecond() (float64, error) {
+	speed := strings.TrimSpace(p.Speed)
+	speed = strings.TrimSuffix(speed, "/sec")
+	speed = strings.TrimSuffix(speed, "/s")
+	speed = strings.TrimSpace(speed)
+
+	// Split number and unit, which may or may not be separated by a space
+	i := strings.IndexFunc(speed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	number, unit := speed, ""
+	if i >= 0 {
+		number, unit = strings.TrimSpace(speed[:i]), strings.TrimSpace(speed[i:])
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unrecognized parity check speed: %q", p.Speed)
+	}
+
+	multipliers := map[string]float64{
+		"": 1, "B": 1,
+		"KB": 1e3, "MB": 1e6, "GB": 1e9, "TB": 1e12,
+		"KIB": 1 << 10, "MIB": 1 << 20, "GIB": 1 << 30, "TIB": 1 << 40,
+	}
+	multiplier, ok := multipliers[strings.ToUpper(unit)]
+	if !ok {
+		return 0, fmt.Errorf("unrecognized parity check speed unit: %q", p.Speed)
+	}
+
+	return value * multiplier, nil
+}
+
 // GetParityCheckStatus retrieves current parity check status
 func (c *Client) GetParityCheckStatus(ctx context.Context) (*ParityCheck, error) {
 	query := `
@@ -953,6 +2049,92 @@ func (c *Client) CancelParityCheck(ctx context.Context) error {
 	return nil
 }
 
+// ParityCheckSchedule is the schedule for automatic parity checks.
+// Mode is one of DISABLED, DAILY, WEEKLY, MONTHLY or YEARLY; DayOfWeek
+// (0 = Sunday) applies to weekly checks, DayOfMonth to monthly and yearly
+// checks and Month (1-12) to yearly checks. Cumulative checks run in
+// increments of Duration hours, resuming every Frequency days until done.
+type ParityCheckSchedule struct {
+	Mode       string `json:"mode" yaml:"mode"`
+	DayOfWeek  int    `json:"dayOfWeek" yaml:"dayOfWeek"`
+	DayOfMonth int    `json:"dayOfMonth" yaml:"dayOfMonth"`
+	Month      int    `json:"month" yaml:"month"`
+	Hour       int    `json:"hour" yaml:"hour"`
+	Minute     int    `json:"minute" yaml:"minute"`
+	Correcting bool   `json:"correcting" yaml:"correcting"`
+	Cumulative bool   `json:"cumulative" yaml:"cumulative"`
+	Frequency  int    `json:"frequency" yaml:"frequency"`
+	Duration   int    `json:"duration" yaml:"duration"`
+}
+
+// GetParityCheckSchedule retrieves the parity check schedule
+func (c *Client) GetParityCheckSchedule(ctx context.Context) (*ParityCheckSchedule, error) {
+	query := `
+		query {
+			parityCheckSchedule {
+				mode
+				dayOfWeek
+				dayOfMonth
+				month
+				hour
+				minute
+				correcting
+				cumulative
+				frequency
+				duration
+			}
+		}
+	`
+
+	var response struct {
+		ParityCheckSchedule ParityCheckSchedule `json:"parityCheckSchedule"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.ParityCheckSchedule, nil
+}
+
+// SetParityCheckSchedule replaces the parity check schedule
+func (c *Client) SetParityCheckSchedule(ctx context.Context, schedule ParityCheckSchedule) (*ParityCheckSchedule, error) {
+	mutation := `
+		mutation($input: ParityCheckScheduleInput!) {
+			parityCheck {
+				setSchedule(input: $input) {
+					mode
+					dayOfWeek
+					dayOfMonth
+					month
+					hour
+					minute
+					correcting
+					cumulative
+					frequency
+					duration
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": schedule,
+	}
+
+	var response struct {
+		ParityCheck struct {
+			SetSchedule ParityCheckSchedule `json:"setSchedule"`
+		} `json:"parityCheck"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.ParityCheck.SetSchedule, nil
+}
+
 // Notification represents a system notification
 type Notification struct {
 	ID          string `json:"id"`
@@ -965,6 +2147,23 @@ type Notification struct {
 	Timestamp   string `json:"timestamp"`
 }
 
+// notificationTimeLayouts are the timestamp formats used by notifications
+var notificationTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// Time parses the notification's timestamp
+func (n Notification) Time() (time.Time, error) {
+	for _, layout := range notificationTimeLayouts {
+		if t, err := time.ParseInLocation(layout, n.Timestamp, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", n.Timestamp)
+}
+
 // NotificationCounts contains notification counts by importance
 type NotificationCounts struct {
 	Info    int `json:"info"`
@@ -1044,42 +2243,286 @@ func (c *Client) GetNotificationOverview(ctx context.Context) (*NotificationOver
 		}
 	`
 
-	var response struct {
-		Notifications struct {
-			Overview NotificationOverview `json:"overview"`
-		} `json:"notifications"`
+	var response struct {
+		Notifications struct {
+			Overview NotificationOverview `json:"overview"`
+		} `json:"notifications"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Notifications.Overview, nil
+}
+
+// ArchiveNotification archives a notification
+func (c *Client) ArchiveNotification(ctx context.Context, id string) error {
+	mutation := `
+		mutation($id: PrefixedID!) {
+			archiveNotification(id: $id) {
+				id
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NotificationInput holds the fields of a new notification. Importance is
+// ALERT, WARNING or INFO.
+type NotificationInput struct {
+	Title       string `json:"title"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Importance  string `json:"importance"`
+	Link        string `json:"link,omitempty"`
+}
+
+// CreateNotification creates a notification in the server's notification center
+func (c *Client) CreateNotification(ctx context.Context, input NotificationInput) (*Notification, error) {
+	mutation := `
+		mutation($input: NotificationData!) {
+			createNotification(input: $input) {
+				id
+				title
+				subject
+				description
+				importance
+				link
+				type
+				timestamp
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		CreateNotification Notification `json:"createNotification"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.CreateNotification, nil
+}
+
+// ArchiveNotifications archives several notifications at once, falling back
+// to archiving them one by one on servers without the bulk mutation
+func (c *Client) ArchiveNotifications(ctx context.Context, ids []string) error {
+	mutation := `
+		mutation($ids: [PrefixedID!]!) {
+			archiveNotifications(ids: $ids) {
+				unread {
+					total
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"ids": ids,
+	}
+
+	var response map[string]interface{}
+
+	err := c.Mutate(ctx, mutation, variables, &response)
+	if !isUnsupportedField(err) {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := c.ArchiveNotification(ctx, id); err != nil {
+			return fmt.Errorf("notification %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ArchiveAllNotifications archives all unread notifications, optionally only
+// those of the given importance, falling back to one call per notification on
+// servers without the bulk mutation
+func (c *Client) ArchiveAllNotifications(ctx context.Context, importance string) error {
+	mutation := `
+		mutation($importance: NotificationImportance) {
+			archiveAll(importance: $importance) {
+				unread {
+					total
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{}
+
+	if importance != "" {
+		variables["importance"] = importance
+	}
+
+	var response map[string]interface{}
+
+	err := c.Mutate(ctx, mutation, variables, &response)
+	if !isUnsupportedField(err) {
+		return err
+	}
+
+	notifications, err := c.GetAllNotifications(ctx, "UNREAD", importance)
+	if err != nil {
+		return err
+	}
+
+	for _, notif := range notifications {
+		if err := c.ArchiveNotification(ctx, notif.ID); err != nil {
+			return fmt.Errorf("notification %s: %w", notif.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// UnarchiveNotifications marks archived notifications as unread again,
+// falling back to one call per notification on servers without the bulk
+// mutation
+func (c *Client) UnarchiveNotifications(ctx context.Context, ids []string) error {
+	mutation := `
+		mutation($ids: [PrefixedID!]!) {
+			unarchiveNotifications(ids: $ids) {
+				unread {
+					total
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"ids": ids,
+	}
+
+	var response map[string]interface{}
+
+	err := c.Mutate(ctx, mutation, variables, &response)
+	if !isUnsupportedField(err) {
+		return err
+	}
+
+	single := `
+		mutation($id: PrefixedID!) {
+			unreadNotification(id: $id) {
+				id
+			}
+		}
+	`
+
+	for _, id := range ids {
+		if err := c.Mutate(ctx, single, map[string]interface{}{"id": id}, &response); err != nil {
+			return fmt.Errorf("notification %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteNotification permanently deletes a notification of the given type
+// (UNREAD or ARCHIVE)
+func (c *Client) DeleteNotification(ctx context.Context, id string, notifType string) error {
+	mutation := `
+		mutation($id: PrefixedID!, $type: NotificationType!) {
+			deleteNotification(id: $id, type: $type) {
+				archive {
+					total
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":   id,
+		"type": notifType,
 	}
 
-	if err := c.Query(ctx, query, nil, &response); err != nil {
-		return nil, err
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
 	}
 
-	return &response.Notifications.Overview, nil
+	return nil
 }
 
-// ArchiveNotification archives a notification
-func (c *Client) ArchiveNotification(ctx context.Context, id string) error {
+// DeleteArchivedNotifications permanently deletes all archived notifications,
+// falling back to one call per notification on servers without the bulk
+// mutation
+func (c *Client) DeleteArchivedNotifications(ctx context.Context) error {
 	mutation := `
-		mutation($id: PrefixedID!) {
-			archiveNotification(id: $id) {
-				id
+		mutation {
+			deleteArchivedNotifications {
+				archive {
+					total
+				}
 			}
 		}
 	`
 
-	variables := map[string]interface{}{
-		"id": id,
-	}
-
 	var response map[string]interface{}
 
-	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+	err := c.Mutate(ctx, mutation, nil, &response)
+	if !isUnsupportedField(err) {
+		return err
+	}
+
+	notifications, err := c.GetAllNotifications(ctx, "ARCHIVE", "")
+	if err != nil {
 		return err
 	}
 
+	for _, notif := range notifications {
+		if err := c.DeleteNotification(ctx, notif.ID, "ARCHIVE"); err != nil {
+			return fmt.Errorf("notification %s: %w", notif.ID, err)
+		}
+	}
+
 	return nil
 }
 
+// GetAllNotifications retrieves every notification of a type, one page at a time
+func (c *Client) GetAllNotifications(ctx context.Context, notifType string, importance string) ([]Notification, error) {
+	const pageSize = 100
+
+	var all []Notification
+	for offset := 0; ; offset += pageSize {
+		page, err := c.GetNotifications(ctx, notifType, importance, offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+		if len(page) < pageSize {
+			return all, nil
+		}
+	}
+}
+
+// isUnsupportedField reports whether err is a GraphQL validation error for a
+// field the server does not know, i.e. an older API version
+func isUnsupportedField(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "Cannot query field")
+}
+
 // LogFile represents a log file on the system
 type LogFile struct {
 	Name       string `json:"name"`
@@ -1158,10 +2601,16 @@ func (c *Client) GetLogFile(ctx context.Context, path string, lines int, startLi
 
 // Plugin represents a plugin on the Unraid system
 type Plugin struct {
-	Name         string `json:"name"`
-	Version      string `json:"version"`
-	HasApiModule *bool  `json:"hasApiModule"`
-	HasCliModule *bool  `json:"hasCliModule"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	LatestVersion string `json:"latestVersion"`
+	HasApiModule  *bool  `json:"hasApiModule"`
+	HasCliModule  *bool  `json:"hasCliModule"`
+}
+
+// UpdateAvailable reports whether a newer version of the plugin is available
+func (p Plugin) UpdateAvailable() bool {
+	return p.LatestVersion != "" && p.LatestVersion != p.Version
 }
 
 // GetPlugins retrieves the list of installed plugins
@@ -1171,6 +2620,7 @@ func (c *Client) GetPlugins(ctx context.Context) ([]Plugin, error) {
 			plugins {
 				name
 				version
+				latestVersion
 				hasApiModule
 				hasCliModule
 			}
@@ -1241,3 +2691,331 @@ func (c *Client) RemovePlugin(ctx context.Context, names []string, bundled bool,
 
 	return nil
 }
+
+// UpdatePlugin updates one or more plugins to their latest versions
+func (c *Client) UpdatePlugin(ctx context.Context, names []string, restart bool) error {
+	mutation := `
+		mutation($input: PluginManagementInput!) {
+			updatePlugin(input: $input)
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"names":   names,
+			"bundled": false,
+			"restart": restart,
+		},
+	}
+
+	var response struct {
+		UpdatePlugin bool `json:"updatePlugin"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestartAPI restarts the Unraid API so that plugin changes take effect
+func (c *Client) RestartAPI(ctx context.Context) error {
+	mutation := `
+		mutation {
+			restartApi
+		}
+	`
+
+	var response map[string]interface{}
+
+	if err := c.Mutate(ctx, mutation, nil, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// APIKey represents an API key. Key holds the secret and is only set on the
+// key returned by CreateAPIKey.
+type APIKey struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Roles       []string           `json:"roles"`
+	Permissions []APIKeyPermission `json:"permissions"`
+	CreatedAt   string             `json:"createdAt"`
+	Key         string             `json:"key,omitempty"`
+}
+
+// APIKeyPermission grants actions on a resource
+type APIKeyPermission struct {
+	Resource string   `json:"resource"`
+	Actions  []string `json:"actions"`
+}
+
+// APIKeyInput contains the settings of a new API key
+type APIKeyInput struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Roles       []string           `json:"roles,omitempty"`
+	Permissions []APIKeyPermission `json:"permissions,omitempty"`
+}
+
+// GetAPIKeys retrieves all API keys
+func (c *Client) GetAPIKeys(ctx context.Context) ([]APIKey, error) {
+	query := `
+		query {
+			apiKeys {
+				id
+				name
+				description
+				roles
+				createdAt
+				permissions {
+					resource
+					actions
+				}
+			}
+		}
+	`
+
+	var response struct {
+		APIKeys []APIKey `json:"apiKeys"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.APIKeys, nil
+}
+
+// FindAPIKey finds an API key by ID, name, or ID prefix
+func (c *Client) FindAPIKey(ctx context.Context, nameOrID string) (*APIKey, error) {
+	keys, err := c.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try exact ID match first
+	for i, key := range keys {
+		if key.ID == nameOrID {
+			return &keys[i], nil
+		}
+	}
+
+	// Try name match
+	for i, key := range keys {
+		if key.Name == nameOrID {
+			return &keys[i], nil
+		}
+	}
+
+	// Try partial ID match
+	for i, key := range keys {
+		if strings.HasPrefix(key.ID, nameOrID) {
+			return &keys[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("API key not found: %s", nameOrID)
+}
+
+// CreateAPIKey creates an API key and returns it with its secret
+func (c *Client) CreateAPIKey(ctx context.Context, input APIKeyInput) (*APIKey, error) {
+	mutation := `
+		mutation($input: CreateApiKeyInput!) {
+			apiKey {
+				create(input: $input) {
+					id
+					key
+					name
+					description
+					roles
+					createdAt
+					permissions {
+						resource
+						actions
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		APIKey struct {
+			Create APIKey `json:"create"`
+		} `json:"apiKey"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.APIKey.Create, nil
+}
+
+// DeleteAPIKeys deletes API keys by ID
+func (c *Client) DeleteAPIKeys(ctx context.Context, ids []string) error {
+	mutation := `
+		mutation($input: DeleteApiKeyInput!) {
+			apiKey {
+				delete(input: $input)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"ids": ids,
+		},
+	}
+
+	var response struct {
+		APIKey struct {
+			Delete bool `json:"delete"`
+		} `json:"apiKey"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// User represents an Unraid user account
+type User struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Roles       []string `json:"roles"`
+}
+
+// GetUsers retrieves all user accounts
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	query := `
+		query {
+			users {
+				id
+				name
+				description
+				roles
+			}
+		}
+	`
+
+	var response struct {
+		Users []User `json:"users"`
+	}
+
+	if err := c.Query(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Users, nil
+}
+
+// AddUser creates a user account
+func (c *Client) AddUser(ctx context.Context, name, password, description string) (*User, error) {
+	mutation := `
+		mutation($input: AddUserInput!) {
+			user {
+				add(input: $input) {
+					id
+					name
+					description
+					roles
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":        name,
+			"password":    password,
+			"description": description,
+		},
+	}
+
+	var response struct {
+		User struct {
+			Add User `json:"add"`
+		} `json:"user"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.User.Add, nil
+}
+
+// DeleteUser deletes a user account
+func (c *Client) DeleteUser(ctx context.Context, name string) error {
+	mutation := `
+		mutation($input: DeleteUserInput!) {
+			user {
+				delete(input: $input) {
+					id
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name": name,
+		},
+	}
+
+	var response struct {
+		User struct {
+			Delete struct {
+				ID string `json:"id"`
+			} `json:"delete"`
+		} `json:"user"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetUserPassword changes the password of a user account
+func (c *Client) SetUserPassword(ctx context.Context, name, password string) error {
+	mutation := `
+		mutation($input: SetUserPasswordInput!) {
+			user {
+				setPassword(input: $input)
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":     name,
+			"password": password,
+		},
+	}
+
+	var response struct {
+		User struct {
+			SetPassword bool `json:"setPassword"`
+		} `json:"user"`
+	}
+
+	if err := c.Mutate(ctx, mutation, variables, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/01dnot/unraidcli/internal/forward"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,6 +20,7 @@ type Config struct {
 	DefaultServer string                  `yaml:"default_server"`
 	OutputFormat  string                  `yaml:"output_format"`
 	Servers       map[string]ServerConfig `yaml:"servers"`
+	Forward       forward.Config          `yaml:"forward,omitempty"`
 }
 
 // GetConfigPath returns the path to the config file
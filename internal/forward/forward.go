@@ -0,0 +1,215 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Config is the "forward" section of the configuration file
+type Config struct {
+	Interval int          `yaml:"interval,omitempty"`
+	Retries  int          `yaml:"retries,omitempty"`
+	Sinks    []SinkConfig `yaml:"sinks,omitempty"`
+}
+
+// SinkConfig configures one destination for forwarded notifications.
+// Type is one of webhook, ntfy, gotify, slack, discord, or smtp. Importance
+// limits the sink to notifications of the listed importances (all when
+// empty). Title and Body are text/template templates over Message.
+type SinkConfig struct {
+	Name       string            `yaml:"name"`
+	Type       string            `yaml:"type"`
+	URL        string            `yaml:"url,omitempty"`
+	Token      string            `yaml:"token,omitempty"`
+	Headers    map[string]string `yaml:"headers,omitempty"`
+	Importance []string          `yaml:"importance,omitempty"`
+	Title      string            `yaml:"title,omitempty"`
+	Body       string            `yaml:"body,omitempty"`
+
+	// SMTP settings
+	Host     string   `yaml:"host,omitempty"`
+	Port     int      `yaml:"port,omitempty"`
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+	From     string   `yaml:"from,omitempty"`
+	To       []string `yaml:"to,omitempty"`
+}
+
+// Default templates for the title and body of forwarded notifications
+const (
+	DefaultTitle = "[{{.Server}}] {{.Title}}: {{.Subject}}"
+	DefaultBody  = "{{.Description}}{{if .Link}}\n{{.Link}}{{end}}"
+)
+
+// DefaultRetries is the number of retries after a failed delivery
+const DefaultRetries = 3
+
+// Message is a notification to forward
+type Message struct {
+	ID          string `json:"id"`
+	Server      string `json:"server"`
+	Title       string `json:"title"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Importance  string `json:"importance"`
+	Link        string `json:"link,omitempty"`
+	Timestamp   string `json:"timestamp"`
+}
+
+// Rendered is a message with its title and body rendered for a sink
+type Rendered struct {
+	Message
+	RenderedTitle string
+	RenderedBody  string
+}
+
+// Sink delivers rendered messages to one destination
+type Sink interface {
+	Send(ctx context.Context, msg Rendered) error
+}
+
+// Target is a configured sink with its filter and templates
+type Target struct {
+	Name       string
+	sink       Sink
+	importance map[string]bool
+	title      *template.Template
+	body       *template.Template
+}
+
+// NewTarget validates a sink configuration and creates its target
+func NewTarget(cfg SinkConfig) (*Target, error) {
+	name := cfg.Name
+	if name == "" {
+		name = cfg.Type
+	}
+
+	sink, err := newSink(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("sink '%s': %w", name, err)
+	}
+
+	titleText := cfg.Title
+	if titleText == "" {
+		titleText = DefaultTitle
+	}
+	title, err := template.New("title").Parse(titleText)
+	if err != nil {
+		return nil, fmt.Errorf("sink '%s': invalid title template: %w", name, err)
+	}
+
+	bodyText := cfg.Body
+	if bodyText == "" {
+		bodyText = DefaultBody
+	}
+	body, err := template.New("body").Parse(bodyText)
+	if err != nil {
+		return nil, fmt.Errorf("sink '%s': invalid body template: %w", name, err)
+	}
+
+	target := &Target{
+		Name:  name,
+		sink:  sink,
+		title: title,
+		body:  body,
+	}
+
+	if len(cfg.Importance) > 0 {
+		target.importance = make(map[string]bool)
+		for _, importance := range cfg.Importance {
+			target.importance[strings.ToUpper(importance)] = true
+		}
+	}
+
+	return target, nil
+}
+
+// NewTargets creates the targets for a list of sink configurations. Sink
+// names identify queued redeliveries, so they must be unique.
+func NewTargets(cfgs []SinkConfig) ([]*Target, error) {
+	var targets []*Target
+	seen := make(map[string]bool)
+
+	for _, cfg := range cfgs {
+		target, err := NewTarget(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if seen[target.Name] {
+			return nil, fmt.Errorf("sink name '%s' is used more than once; give each sink a unique name", target.Name)
+		}
+		seen[target.Name] = true
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// Accepts reports whether the target's importance filter lets msg through
+func (t *Target) Accepts(msg Message) bool {
+	return t.importance == nil || t.importance[strings.ToUpper(msg.Importance)]
+}
+
+// Deliver renders msg and sends it, retrying failed attempts with
+// exponential backoff (1s, 2s, 4s, ... capped at one minute)
+func (t *Target) Deliver(ctx context.Context, msg Message, retries int) error {
+	rendered, err := t.render(msg)
+	if err != nil {
+		return err
+	}
+
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		err = t.sink.Send(ctx, rendered)
+
+		var permanent *PermanentError
+		if err == nil || errors.As(err, &permanent) || attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, time.Minute)
+	}
+}
+
+// render applies the target's templates to msg
+func (t *Target) render(msg Message) (Rendered, error) {
+	var title, body bytes.Buffer
+
+	if err := t.title.Execute(&title, msg); err != nil {
+		return Rendered{}, fmt.Errorf("failed to render title: %w", err)
+	}
+	if err := t.body.Execute(&body, msg); err != nil {
+		return Rendered{}, fmt.Errorf("failed to render body: %w", err)
+	}
+
+	return Rendered{
+		Message:       msg,
+		RenderedTitle: title.String(),
+		RenderedBody:  body.String(),
+	}, nil
+}
+
+// PermanentError is a delivery failure that retrying will not fix, such as
+// a rejected request
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,238 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// newSink creates the sink for a configuration
+func newSink(cfg SinkConfig) (Sink, error) {
+	switch strings.ToLower(cfg.Type) {
+	case "webhook":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required")
+		}
+		return &webhookSink{url: cfg.URL, headers: cfg.Headers}, nil
+	case "ntfy":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required (e.g. https://ntfy.sh/my-topic)")
+		}
+		return &ntfySink{url: cfg.URL, token: cfg.Token}, nil
+	case "gotify":
+		if cfg.URL == "" || cfg.Token == "" {
+			return nil, fmt.Errorf("url and token are required")
+		}
+		return &gotifySink{url: strings.TrimRight(cfg.URL, "/") + "/message", token: cfg.Token}, nil
+	case "slack":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required")
+		}
+		return &chatSink{url: cfg.URL, field: "text", bold: "*"}, nil
+	case "discord":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("url is required")
+		}
+		return &chatSink{url: cfg.URL, field: "content", bold: "**"}, nil
+	case "smtp":
+		if cfg.Host == "" || cfg.From == "" || len(cfg.To) == 0 {
+			return nil, fmt.Errorf("host, from, and to are required")
+		}
+		port := cfg.Port
+		if port == 0 {
+			port = 587
+		}
+		return &smtpSink{
+			addr:     net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+			host:     cfg.Host,
+			username: cfg.Username,
+			password: cfg.Password,
+			from:     cfg.From,
+			to:       cfg.To,
+		}, nil
+	case "":
+		return nil, fmt.Errorf("type is required")
+	default:
+		return nil, fmt.Errorf("unknown type '%s' (use webhook, ntfy, gotify, slack, discord, or smtp)", cfg.Type)
+	}
+}
+
+// httpClient is shared by the HTTP-based sinks
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// post sends an HTTP POST request and treats non-2xx responses as errors.
+// Client errors other than 408 and 429 are permanent.
+func post(ctx context.Context, target string, contentType string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(detail)))
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return &PermanentError{Err: err}
+	}
+	return err
+}
+
+// postJSON sends payload as a JSON POST request
+func postJSON(ctx context.Context, target string, payload interface{}, headers map[string]string) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	return post(ctx, target, "application/json", body, headers)
+}
+
+// webhookSink posts the notification and its rendered text as JSON
+type webhookSink struct {
+	url     string
+	headers map[string]string
+}
+
+func (s *webhookSink) Send(ctx context.Context, msg Rendered) error {
+	payload := map[string]interface{}{
+		"title":        msg.RenderedTitle,
+		"body":         msg.RenderedBody,
+		"notification": msg.Message,
+	}
+	return postJSON(ctx, s.url, payload, s.headers)
+}
+
+// ntfySink publishes to an ntfy topic URL
+type ntfySink struct {
+	url   string
+	token string
+}
+
+func (s *ntfySink) Send(ctx context.Context, msg Rendered) error {
+	priority := "default"
+	switch strings.ToUpper(msg.Importance) {
+	case "ALERT":
+		priority = "urgent"
+	case "WARNING":
+		priority = "high"
+	}
+
+	// Header values must be ASCII; ntfy decodes RFC 2047 encoded titles
+	headers := map[string]string{
+		"Title":    mime.QEncoding.Encode("utf-8", msg.RenderedTitle),
+		"Priority": priority,
+		"Tags":     strings.ToLower(msg.Importance),
+	}
+	if msg.Link != "" {
+		headers["Click"] = msg.Link
+	}
+	if s.token != "" {
+		headers["Authorization"] = "Bearer " + s.token
+	}
+
+	return post(ctx, s.url, "text/plain; charset=utf-8", []byte(msg.RenderedBody), headers)
+}
+
+// gotifySink posts to a Gotify server's message endpoint
+type gotifySink struct {
+	url   string
+	token string
+}
+
+func (s *gotifySink) Send(ctx context.Context, msg Rendered) error {
+	priority := 2
+	switch strings.ToUpper(msg.Importance) {
+	case "ALERT":
+		priority = 8
+	case "WARNING":
+		priority = 5
+	}
+
+	payload := map[string]interface{}{
+		"title":    msg.RenderedTitle,
+		"message":  msg.RenderedBody,
+		"priority": priority,
+	}
+	return postJSON(ctx, s.url, payload, map[string]string{"X-Gotify-Key": s.token})
+}
+
+// chatSink posts to Slack and Discord incoming webhooks, which differ only
+// in the name of the text field and the bold markup
+type chatSink struct {
+	url   string
+	field string
+	bold  string
+}
+
+func (s *chatSink) Send(ctx context.Context, msg Rendered) error {
+	text := s.bold + msg.RenderedTitle + s.bold
+	if msg.RenderedBody != "" {
+		text += "\n" + msg.RenderedBody
+	}
+	return postJSON(ctx, s.url, map[string]string{s.field: text}, nil)
+}
+
+// smtpSink sends a plain-text email
+type smtpSink struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+func (s *smtpSink) Send(ctx context.Context, msg Rendered) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", s.from)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.RenderedTitle))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&body, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&body, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(msg.RenderedBody, "\n", "\r\n"))
+	body.WriteString("\r\n")
+
+	// net/smtp has no context support, so run it in the background and
+	// give up waiting when the context ends
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.addr, auth, s.from, s.to, body.Bytes())
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
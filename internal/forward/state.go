@@ -0,0 +1,162 @@
+package forward
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State is the "last seen" cursor of the forwarder: the timestamp of the
+// newest forwarded notification, and the IDs of the notifications forwarded
+// with exactly that timestamp. Retries holds messages that some sinks have
+// not accepted yet, oldest first.
+type State struct {
+	Last    time.Time `json:"last"`
+	IDs     []string  `json:"ids"`
+	Retries []Retry   `json:"retries,omitempty"`
+
+	path string
+}
+
+// Retry is a message awaiting redelivery to the named sinks. Attempts counts
+// the failed redeliveries, and Next is when the next one is due.
+type Retry struct {
+	Message  Message   `json:"message"`
+	Sinks    []string  `json:"sinks"`
+	Attempts int       `json:"attempts"`
+	Next     time.Time `json:"next"`
+}
+
+// Limits for queued redeliveries: the number of redeliveries before a
+// message is dropped, the number of queued messages before the oldest is
+// dropped, and the backoff between redeliveries (doubling up to the max)
+const (
+	MaxRetryAttempts = 8
+	MaxQueuedRetries = 100
+	RetryBackoff     = time.Minute
+	MaxRetryBackoff  = time.Hour
+)
+
+// retryDelay returns the backoff before redelivery after the given number
+// of failed attempts
+func retryDelay(attempts int) time.Duration {
+	delay := RetryBackoff
+	for i := 0; i < attempts && delay < MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryBackoff)
+}
+
+// Due reports whether the redelivery is due at now
+func (r *Retry) Due(now time.Time) bool {
+	return !now.Before(r.Next)
+}
+
+// Failed records a failed redelivery to the given sinks and schedules the
+// next one. It returns false once the message has used up its attempts and
+// should be dropped.
+func (r *Retry) Failed(sinks []string, now time.Time) bool {
+	r.Sinks = sinks
+	r.Attempts++
+	r.Next = now.Add(retryDelay(r.Attempts))
+	return r.Attempts < MaxRetryAttempts
+}
+
+// QueueRetry queues msg for redelivery to the given sinks. If the queue is
+// full, the oldest entries are dropped and returned.
+func (s *State) QueueRetry(msg Message, sinks []string, now time.Time) []Retry {
+	s.Retries = append(s.Retries, Retry{Message: msg, Sinks: sinks, Next: now.Add(retryDelay(0))})
+
+	var dropped []Retry
+	if excess := len(s.Retries) - MaxQueuedRetries; excess > 0 {
+		dropped = append(dropped, s.Retries[:excess]...)
+		s.Retries = s.Retries[excess:]
+	}
+	return dropped
+}
+
+// GetStateDir returns the directory where forwarder state is stored
+func GetStateDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".unraidcli", "forward"), nil
+}
+
+// LoadState reads the forwarder state for a server profile. ok is false if
+// no state has been saved yet.
+func LoadState(server string) (state *State, ok bool, err error) {
+	dir, err := GetStateDir()
+	if err != nil {
+		return nil, false, err
+	}
+
+	state = &State{path: filepath.Join(dir, server+".json")}
+
+	data, err := os.ReadFile(state.path)
+	if os.IsNotExist(err) {
+		return state, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read forward state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, false, fmt.Errorf("failed to parse forward state %s: %w", state.path, err)
+	}
+
+	return state, true, nil
+}
+
+// Seen reports whether a notification with the given timestamp and ID has
+// already been forwarded
+func (s *State) Seen(t time.Time, id string) bool {
+	if t.Before(s.Last) {
+		return true
+	}
+	if t.After(s.Last) {
+		return false
+	}
+	for _, seen := range s.IDs {
+		if seen == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Mark advances the cursor past a forwarded notification
+func (s *State) Mark(t time.Time, id string) {
+	if t.After(s.Last) {
+		s.Last = t
+		s.IDs = nil
+	}
+	if t.Equal(s.Last) {
+		s.IDs = append(s.IDs, id)
+	}
+}
+
+// Save writes the state to disk, replacing the previous file atomically
+func (s *State) Save() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create forward state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal forward state: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write forward state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write forward state: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/01dnot/unraidcli/internal/stats"
+)
+
+// Usage is the used and total size of an array, disk, or share in bytes
+type Usage struct {
+	Used int64 `json:"used"`
+	Size int64 `json:"size"`
+}
+
+// Sample is a point-in-time record of array, disk, and share usage
+type Sample struct {
+	Time   time.Time        `json:"time"`
+	Array  Usage            `json:"array"`
+	Disks  map[string]Usage `json:"disks"`
+	Shares map[string]Usage `json:"shares"`
+}
+
+// Store is an append-only file of usage samples for one server
+type Store struct {
+	path string
+}
+
+// GetHistoryDir returns the directory where usage history is stored
+func GetHistoryDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".unraidcli", "history"), nil
+}
+
+// Open returns the history store for the given server profile
+func Open(server string) (*Store, error) {
+	dir, err := GetHistoryDir()
+	if err != nil {
+		return nil, err
+	}
+	return &Store{path: filepath.Join(dir, server+".jsonl")}, nil
+}
+
+// Path returns the path of the store's file
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Append adds a sample to the store
+func (s *Store) Append(sample Sample) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sample: %w", err)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	return nil
+}
+
+// Load returns all samples taken at or after since, oldest first
+func (s *Store) Load(since time.Time) ([]Sample, error) {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer file.Close()
+
+	var samples []Sample
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+
+	for scanner.Scan() {
+		var sample Sample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// Skip partially written lines
+			continue
+		}
+		if !sample.Time.Before(since) {
+			samples = append(samples, sample)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+
+	return samples, nil
+}
+
+// Forecast is the fitted growth trend of an array, disk, or share
+type Forecast struct {
+	Kind          string     `json:"kind" yaml:"kind"`
+	Name          string     `json:"name" yaml:"name"`
+	Used          int64      `json:"usedBytes" yaml:"usedBytes"`
+	Size          int64      `json:"sizeBytes" yaml:"sizeBytes"`
+	GrowthPerDay  float64    `json:"growthBytesPerDay" yaml:"growthBytesPerDay"`
+	DaysUntilFull *float64   `json:"daysUntilFull" yaml:"daysUntilFull"`
+	FullDate      *time.Time `json:"fullDate,omitempty" yaml:"fullDate,omitempty"`
+	Samples       int        `json:"samples" yaml:"samples"`
+}
+
+// UsedPercent returns the percentage in use at the latest sample
+func (f *Forecast) UsedPercent() float64 {
+	if f.Size <= 0 {
+		return 0
+	}
+	return float64(f.Used) / float64(f.Size) * 100
+}
+
+// point is a single observation of a series
+type point struct {
+	time time.Time
+	used int64
+	size int64
+}
+
+// BuildForecasts fits a linear growth trend to the array, each disk, and
+// each share across the samples. Entities with fewer than two samples are
+// skipped. DaysUntilFull is nil when usage is not growing.
+func BuildForecasts(samples []Sample) []Forecast {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	type key struct{ kind, name string }
+	series := make(map[key][]point)
+	var order []key
+
+	add := func(k key, t time.Time, usage Usage) {
+		if _, ok := series[k]; !ok {
+			order = append(order, k)
+		}
+		series[k] = append(series[k], point{time: t, used: usage.Used, size: usage.Size})
+	}
+
+	for _, sample := range samples {
+		add(key{"array", "array"}, sample.Time, sample.Array)
+		for _, name := range sortedKeys(sample.Disks) {
+			add(key{"disk", name}, sample.Time, sample.Disks[name])
+		}
+		for _, name := range sortedKeys(sample.Shares) {
+			add(key{"share", name}, sample.Time, sample.Shares[name])
+		}
+	}
+
+	var forecasts []Forecast
+	for _, k := range order {
+		points := series[k]
+		if len(points) < 2 {
+			continue
+		}
+
+		start := points[0].time
+		xs := make([]float64, len(points))
+		ys := make([]float64, len(points))
+		for i, p := range points {
+			xs[i] = p.time.Sub(start).Hours() / 24
+			ys[i] = float64(p.used)
+		}
+
+		latest := points[len(points)-1]
+		forecast := Forecast{
+			Kind:    k.kind,
+			Name:    k.name,
+			Used:    latest.used,
+			Size:    latest.size,
+			Samples: len(points),
+		}
+
+		if slope, _, ok := stats.LinearFit(xs, ys); ok {
+			forecast.GrowthPerDay = slope
+			if latest.used >= latest.size && latest.size > 0 {
+				// Already full, whatever the trend
+				days := 0.0
+				full := latest.time
+				forecast.DaysUntilFull = &days
+				forecast.FullDate = &full
+			} else if slope > 0 && latest.size > latest.used {
+				days := float64(latest.size-latest.used) / slope
+				forecast.DaysUntilFull = &days
+
+				// Dates beyond a century would overflow time.Duration and are meaningless anyway
+				if days < 100*365 {
+					full := latest.time.Add(time.Duration(days * 24 * float64(time.Hour)))
+					forecast.FullDate = &full
+				}
+			}
+		}
+
+		forecasts = append(forecasts, forecast)
+	}
+
+	return forecasts
+}
+
+// sortedKeys returns the keys of a usage map in sorted order
+func sortedKeys(m map[string]Usage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
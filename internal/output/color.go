@@ -149,33 +149,69 @@ func ColorizeState(state string) string {
 	}
 }
 
+// ColorizeImportance returns a notification importance colored by severity
+func ColorizeImportance(importance string) string {
+	importanceUpper := strings.ToUpper(importance)
+
+	switch importanceUpper {
+	case "ALERT":
+		return BoldRed(importanceUpper)
+	case "WARNING":
+		return BoldYellow(importanceUpper)
+	case "INFO":
+		return Colorize(importanceUpper, ColorBoldBlue)
+	default:
+		return importanceUpper
+	}
+}
+
 // ColorizePercentage returns colored percentage based on value
 // High percentages are red, medium are yellow, low are green
 func ColorizePercentage(percent float64, reverse bool) string {
-	text := fmt.Sprintf("%.1f%%", percent)
+	return Colorize(fmt.Sprintf("%.1f%%", percent), percentageColor(percent, reverse))
+}
 
+// percentageColor returns the color for a percentage value
+func percentageColor(percent float64, reverse bool) string {
 	// For things like disk usage, high is bad
 	// For things like CPU idle, high is good (reverse=true)
 
 	if !reverse {
 		// High is bad (disk usage, CPU usage, memory usage)
 		if percent >= 90 {
-			return Red(text)
+			return ColorRed
 		} else if percent >= 75 {
-			return Yellow(text)
+			return ColorYellow
 		}
-		return Green(text)
+		return ColorGreen
 	} else {
 		// High is good (battery level, free space)
 		if percent >= 75 {
-			return Green(text)
+			return ColorGreen
 		} else if percent >= 50 {
-			return Yellow(text)
+			return ColorYellow
 		}
-		return Red(text)
+		return ColorRed
 	}
 }
 
+// ProgressBar returns a plain text bar of the given width filled to percent
+func ProgressBar(percent float64, width int) string {
+	if percent < 0 {
+		percent = 0
+	} else if percent > 100 {
+		percent = 100
+	}
+
+	filled := int(percent / 100 * float64(width))
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+// UsageBar returns a progress bar colored like ColorizePercentage
+func UsageBar(percent float64, width int, reverse bool) string {
+	return Colorize(ProgressBar(percent, width), percentageColor(percent, reverse))
+}
+
 // ColorizeTemperature returns colored temperature based on value
 func ColorizeTemperature(temp float64) string {
 	text := fmt.Sprintf("%.1f°C", temp)
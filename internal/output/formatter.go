@@ -1,10 +1,14 @@
 package output
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -20,6 +24,8 @@ const (
 	FormatJSON Format = "json"
 	// FormatYAML represents YAML output format
 	FormatYAML Format = "yaml"
+	// FormatCSV represents CSV output format
+	FormatCSV Format = "csv"
 )
 
 // Formatter handles output formatting
@@ -33,7 +39,7 @@ func New(format string) *Formatter {
 	f := Format(strings.ToLower(format))
 
 	// Default to table if invalid format
-	if f != FormatTable && f != FormatJSON && f != FormatYAML {
+	if f != FormatTable && f != FormatJSON && f != FormatYAML && f != FormatCSV {
 		f = FormatTable
 	}
 
@@ -50,6 +56,8 @@ func (f *Formatter) Print(data interface{}) error {
 		return f.printJSON(data)
 	case FormatYAML:
 		return f.printYAML(data)
+	case FormatCSV:
+		return f.printCSV(data)
 	default:
 		// Table format is handled by specific methods
 		return fmt.Errorf("table format requires using PrintTable method")
@@ -58,6 +66,11 @@ func (f *Formatter) Print(data interface{}) error {
 
 // PrintTable outputs data as a table
 func (f *Formatter) PrintTable(headers []string, rows [][]string) {
+	if f.format == FormatCSV {
+		f.writeCSV(headers, rows)
+		return
+	}
+
 	if f.format != FormatTable {
 		// If not table format, convert to map and print
 		data := make([]map[string]string, len(rows))
@@ -148,6 +161,105 @@ func (f *Formatter) printYAML(data interface{}) error {
 	return encoder.Encode(data)
 }
 
+// printCSV outputs a list of objects (or a single object) as CSV, one column
+// per field. Nested values are written as JSON.
+func (f *Formatter) printCSV(data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return err
+	}
+
+	var items []interface{}
+	switch value := decoded.(type) {
+	case []interface{}:
+		items = value
+	default:
+		items = []interface{}{value}
+	}
+
+	// Columns are the union of all field names, in sorted order
+	seen := make(map[string]bool)
+	var headers []string
+	for _, item := range items {
+		if object, ok := item.(map[string]interface{}); ok {
+			for key := range object {
+				if !seen[key] {
+					seen[key] = true
+					headers = append(headers, key)
+				}
+			}
+		}
+	}
+	sort.Strings(headers)
+
+	if len(headers) == 0 {
+		headers = []string{"value"}
+	}
+
+	var rows [][]string
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			rows = append(rows, []string{csvValue(item)})
+			continue
+		}
+
+		row := make([]string, len(headers))
+		for i, header := range headers {
+			row[i] = csvValue(object[header])
+		}
+		rows = append(rows, row)
+	}
+
+	return f.writeCSV(headers, rows)
+}
+
+// writeCSV writes headers and rows as CSV, without color codes
+func (f *Formatter) writeCSV(headers []string, rows [][]string) error {
+	w := csv.NewWriter(f.writer)
+
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		clean := make([]string, len(row))
+		for i, cell := range row {
+			clean[i] = ansiPattern.ReplaceAllString(cell, "")
+		}
+		if err := w.Write(clean); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// ansiPattern matches terminal color escape sequences
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// csvValue formats a decoded JSON value as a CSV cell
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		raw, _ := json.Marshal(v)
+		return string(raw)
+	}
+}
+
 // PrintSuccess prints a success message
 func (f *Formatter) PrintSuccess(message string) {
 	if f.format == FormatTable {
@@ -186,6 +298,31 @@ func FormatBytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
+// ParseBytes parses a human-readable size such as "50G", "1.5TB" or "500MiB"
+// into bytes. Units are binary (K = 1024) regardless of the "i" suffix, to
+// match FormatBytes; a bare number is taken as bytes.
+func ParseBytes(value string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "B"), "I")
+
+	multiplier := int64(1)
+	if n := len(s); n > 0 {
+		if exp := strings.IndexByte("KMGTPE", s[n-1]); exp >= 0 {
+			s = strings.TrimSpace(s[:n-1])
+			for i := 0; i <= exp; i++ {
+				multiplier *= 1024
+			}
+		}
+	}
+
+	number, err := strconv.ParseFloat(s, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+
+	return int64(number * float64(multiplier)), nil
+}
+
 // FormatUptime formats uptime in seconds to human-readable format
 func FormatUptime(seconds int64) string {
 	days := seconds / 86400
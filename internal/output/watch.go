@@ -2,6 +2,7 @@ package output
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -12,6 +13,9 @@ import (
 // WatchFunc is a function that gets executed repeatedly in watch mode
 type WatchFunc func() error
 
+// ErrStopWatch can be returned by a WatchFunc to end watch mode without an error
+var ErrStopWatch = errors.New("stop watching")
+
 // Watch executes a function repeatedly with a specified interval
 func Watch(ctx context.Context, interval time.Duration, fn WatchFunc) error {
 	// Clear screen initially
@@ -19,6 +23,9 @@ func Watch(ctx context.Context, interval time.Duration, fn WatchFunc) error {
 
 	// Run immediately first time
 	if err := fn(); err != nil {
+		if errors.Is(err, ErrStopWatch) {
+			return nil
+		}
 		return err
 	}
 
@@ -32,6 +39,9 @@ func Watch(ctx context.Context, interval time.Duration, fn WatchFunc) error {
 		case <-ticker.C:
 			clearScreen()
 			if err := fn(); err != nil {
+				if errors.Is(err, ErrStopWatch) {
+					return nil
+				}
 				return err
 			}
 		}
@@ -0,0 +1,52 @@
+package stats
+
+import "sort"
+
+// LinearFit fits a least-squares line y = slope*x + intercept through the
+// given points. ok is false if there are fewer than two points or all x
+// values are equal.
+func LinearFit(xs, ys []float64) (slope, intercept float64, ok bool) {
+	n := len(xs)
+	if n < 2 || n != len(ys) {
+		return 0, 0, false
+	}
+
+	var sumX, sumY float64
+	for i := 0; i < n; i++ {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX := sumX / float64(n)
+	meanY := sumY / float64(n)
+
+	var num, den float64
+	for i := 0; i < n; i++ {
+		dx := xs[i] - meanX
+		num += dx * (ys[i] - meanY)
+		den += dx * dx
+	}
+
+	if den == 0 {
+		return 0, 0, false
+	}
+
+	slope = num / den
+	intercept = meanY - slope*meanX
+	return slope, intercept, true
+}
+
+// Median returns the median of values, or 0 if values is empty
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}